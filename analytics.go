@@ -0,0 +1,120 @@
+package documentstack
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"time"
+)
+
+// ViewEventType is the kind of document access event.
+type ViewEventType string
+
+// Document access event types.
+const (
+	ShareEventView     ViewEventType = "view"
+	ShareEventDownload ViewEventType = "download"
+)
+
+// AnalyticsService reports how shared and embedded documents are accessed.
+type AnalyticsService struct {
+	client *Client
+}
+
+// ViewEvent is a single view or download of a document.
+type ViewEvent struct {
+	ID          string        `json:"id"`
+	Type        ViewEventType `json:"type"`
+	DocumentID  string        `json:"documentId"`
+	ShareLinkID string        `json:"shareLinkId,omitempty"`
+
+	// UserID is the user of the viewer token, for embedded viewers.
+	UserID string `json:"userId,omitempty"`
+
+	// Country is the viewer's ISO 3166-1 alpha-2 country code, if known.
+	Country   string `json:"country,omitempty"`
+	UserAgent string `json:"userAgent,omitempty"`
+
+	// DurationMs is how long the document was open, for views.
+	DurationMs int64 `json:"durationMs,omitempty"`
+
+	OccurredAt time.Time `json:"occurredAt"`
+}
+
+// ViewEventList is a page of view events.
+type ViewEventList struct {
+	Events     []*ViewEvent `json:"events"`
+	NextCursor string       `json:"nextCursor,omitempty"`
+}
+
+// ViewEventFilter filters and paginates view events.
+type ViewEventFilter struct {
+	ListOptions
+
+	// Type limits results to one event type.
+	Type ViewEventType
+
+	// From and To limit results to events in [From, To). Zero values are unbounded.
+	From time.Time
+	To   time.Time
+}
+
+func (f *ViewEventFilter) values() url.Values {
+	if f == nil {
+		return url.Values{}
+	}
+
+	values := f.ListOptions.values()
+	if f.Type != "" {
+		values.Set("type", string(f.Type))
+	}
+	if !f.From.IsZero() {
+		values.Set("from", f.From.UTC().Format(time.RFC3339))
+	}
+	if !f.To.IsZero() {
+		values.Set("to", f.To.UTC().Format(time.RFC3339))
+	}
+	return values
+}
+
+// DocumentEvents returns a page of view and download events of a stored
+// document, most recent first.
+//
+// Example:
+//
+//	events, err := client.Analytics.DocumentEvents(ctx, proposalID, &documentstack.ViewEventFilter{
+//		Type: documentstack.ShareEventView,
+//	})
+//	opened := err == nil && len(events.Events) > 0
+func (s *AnalyticsService) DocumentEvents(ctx context.Context, documentID string, filter *ViewEventFilter) (*ViewEventList, error) {
+	if documentID == "" {
+		return nil, NewValidationError("Document ID is required", nil)
+	}
+
+	endpoint := fmt.Sprintf("/api/v1/documents/%s/events", url.PathEscape(documentID))
+	return s.events(ctx, endpoint, filter)
+}
+
+// ShareLinkEvents returns a page of view and download events of a share link,
+// most recent first.
+func (s *AnalyticsService) ShareLinkEvents(ctx context.Context, shareLinkID string, filter *ViewEventFilter) (*ViewEventList, error) {
+	if shareLinkID == "" {
+		return nil, NewValidationError("Share link ID is required", nil)
+	}
+
+	endpoint := fmt.Sprintf("/api/v1/share-links/%s/events", url.PathEscape(shareLinkID))
+	return s.events(ctx, endpoint, filter)
+}
+
+func (s *AnalyticsService) events(ctx context.Context, endpoint string, filter *ViewEventFilter) (*ViewEventList, error) {
+	if query := filter.values().Encode(); query != "" {
+		endpoint += "?" + query
+	}
+
+	var result ViewEventList
+	if err := s.client.doJSON(ctx, "GET", endpoint, nil, &result); err != nil {
+		return nil, err
+	}
+
+	return &result, nil
+}
@@ -0,0 +1,116 @@
+package documentstack
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"time"
+)
+
+// API key scopes.
+const (
+	ScopeGenerate       = "generate"
+	ScopeTemplatesRead  = "templates:read"
+	ScopeTemplatesWrite = "templates:write"
+	ScopeAssetsWrite    = "assets:write"
+	ScopeWebhooksWrite  = "webhooks:write"
+	ScopeAdmin          = "admin"
+)
+
+// APIKeysService manages API keys and their scopes.
+type APIKeysService struct {
+	client *Client
+}
+
+// APIKey is an API key in the workspace.
+type APIKey struct {
+	// ID is the unique key identifier. Set by the API.
+	ID string `json:"id,omitempty"`
+
+	// Name is the human-readable key name.
+	Name string `json:"name"`
+
+	// Scopes are the operations the key may perform.
+	Scopes []string `json:"scopes"`
+
+	// Roles are labels that template ACLs grant permissions to, e.g. "hr".
+	// See TemplatesService.SetACL.
+	Roles []string `json:"roles,omitempty"`
+
+	// Prefix is the first characters of the key, for identification. Set by the API.
+	Prefix string `json:"prefix,omitempty"`
+
+	// Key is the secret key. Only returned when the key is created.
+	Key string `json:"key,omitempty"`
+
+	// CreatedAt is the time the key was created. Set by the API.
+	CreatedAt time.Time `json:"createdAt"`
+}
+
+// APIKeyList is a page of API keys.
+type APIKeyList struct {
+	APIKeys    []*APIKey `json:"apiKeys"`
+	NextCursor string    `json:"nextCursor,omitempty"`
+}
+
+// List returns a page of API keys. Secrets are not included.
+func (s *APIKeysService) List(ctx context.Context, opts *ListOptions) (*APIKeyList, error) {
+	var result APIKeyList
+	if err := s.client.doJSON(ctx, "GET", listPath("/api/v1/api-keys", opts), nil, &result); err != nil {
+		return nil, err
+	}
+
+	return &result, nil
+}
+
+// ListAll returns all API keys, fetching every page. See ListAllOptions.
+func (s *APIKeysService) ListAll(ctx context.Context, opts *ListAllOptions) ([]*APIKey, error) {
+	return listAll(ctx, opts, func(page *ListOptions) ([]*APIKey, string, error) {
+		result, err := s.List(ctx, page)
+		if err != nil {
+			return nil, "", err
+		}
+		return result.APIKeys, result.NextCursor, nil
+	})
+}
+
+// Create creates a new API key. The returned key includes its secret, which
+// cannot be retrieved again.
+func (s *APIKeysService) Create(ctx context.Context, name string, scopes []string) (*APIKey, error) {
+	if name == "" {
+		return nil, NewValidationError("API key name is required", nil)
+	}
+
+	var result APIKey
+	if err := s.client.doJSON(ctx, "POST", "/api/v1/api-keys", &APIKey{Name: name, Scopes: scopes}, &result); err != nil {
+		return nil, err
+	}
+
+	return &result, nil
+}
+
+// UpdateScopes replaces the scopes of an existing API key.
+func (s *APIKeysService) UpdateScopes(ctx context.Context, keyID string, scopes []string) (*APIKey, error) {
+	if keyID == "" {
+		return nil, NewValidationError("API key ID is required", nil)
+	}
+
+	var result APIKey
+	endpoint := fmt.Sprintf("/api/v1/api-keys/%s", url.PathEscape(keyID))
+	body := map[string]interface{}{"scopes": scopes}
+	if err := s.client.doJSON(ctx, "PATCH", endpoint, body, &result); err != nil {
+		return nil, err
+	}
+
+	return &result, nil
+}
+
+// Revoke permanently revokes an API key.
+func (s *APIKeysService) Revoke(ctx context.Context, keyID string) error {
+	if keyID == "" {
+		return NewValidationError("API key ID is required", nil)
+	}
+
+	endpoint := fmt.Sprintf("/api/v1/api-keys/%s", url.PathEscape(keyID))
+	return s.client.doJSON(ctx, "DELETE", endpoint, nil, nil)
+}
@@ -0,0 +1,134 @@
+package documentstack
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"io/fs"
+	"mime"
+	"mime/multipart"
+	"net/textproto"
+	"path"
+	"strings"
+)
+
+// AssetsService manages uploaded assets such as images and fonts referenced by templates.
+type AssetsService struct {
+	client *Client
+}
+
+// Asset is a file stored in the workspace.
+type Asset struct {
+	// ID is the unique asset identifier.
+	ID string `json:"id"`
+
+	// Name is the asset name, used to reference the asset from templates.
+	Name string `json:"name"`
+
+	// ContentType is the MIME type of the asset.
+	ContentType string `json:"contentType"`
+
+	// Size is the asset size in bytes.
+	Size int64 `json:"size"`
+
+	// URL is the URL the asset is served from.
+	URL string `json:"url,omitempty"`
+}
+
+// Upload uploads an asset with the given name, replacing any existing asset
+// with that name.
+func (s *AssetsService) Upload(ctx context.Context, name string, r io.Reader) (*Asset, error) {
+	if name == "" {
+		return nil, NewValidationError("Asset name is required", nil)
+	}
+
+	contentType := mime.TypeByExtension(path.Ext(name))
+	if contentType == "" {
+		contentType = "application/octet-stream"
+	}
+
+	var body bytes.Buffer
+	writer := multipart.NewWriter(&body)
+
+	header := make(textproto.MIMEHeader)
+	header.Set("Content-Disposition", fmt.Sprintf(`form-data; name="file"; filename="%s"`, escapeQuotes(name)))
+	header.Set("Content-Type", contentType)
+
+	part, err := writer.CreatePart(header)
+	if err != nil {
+		return nil, &NetworkError{Message: "failed to create multipart body", Cause: err}
+	}
+	if _, err := io.Copy(part, r); err != nil {
+		return nil, &NetworkError{Message: "failed to read asset", Cause: err}
+	}
+	if err := writer.Close(); err != nil {
+		return nil, &NetworkError{Message: "failed to create multipart body", Cause: err}
+	}
+
+	req, err := s.client.newRequest(ctx, "POST", "/api/v1/assets", &body, writer.FormDataContentType())
+	if err != nil {
+		return nil, err
+	}
+
+	var asset Asset
+	if err := s.client.decode(ctx, req, &asset); err != nil {
+		return nil, err
+	}
+
+	return &asset, nil
+}
+
+// UploadFS uploads every file under dir of fsys, such as an embed.FS.
+// Asset names are the slash-separated paths relative to dir, so
+// "assets/images/logo.png" uploaded with dir "assets" is named "images/logo.png".
+// Hidden files and directories, such as .git, are skipped.
+func (s *AssetsService) UploadFS(ctx context.Context, fsys fs.FS, dir string) ([]*Asset, error) {
+	var assets []*Asset
+
+	err := fs.WalkDir(fsys, dir, func(name string, entry fs.DirEntry, err error) error {
+		if err != nil {
+			return &DocumentStackError{Message: fmt.Sprintf("failed to read asset directory %q: %v", dir, err)}
+		}
+		hidden := strings.HasPrefix(entry.Name(), ".") && name != dir
+		if entry.IsDir() {
+			if hidden {
+				return fs.SkipDir
+			}
+			return nil
+		}
+		if hidden {
+			return nil
+		}
+
+		file, err := fsys.Open(name)
+		if err != nil {
+			return &DocumentStackError{Message: fmt.Sprintf("failed to read asset %q: %v", name, err)}
+		}
+		defer file.Close()
+
+		assetName := strings.TrimPrefix(strings.TrimPrefix(name, dir), "/")
+		if dir == "." {
+			assetName = name
+		}
+
+		asset, err := s.Upload(ctx, assetName, file)
+		if err != nil {
+			return err
+		}
+		assets = append(assets, asset)
+
+		return nil
+	})
+	if err != nil {
+		return assets, err
+	}
+
+	return assets, nil
+}
+
+var quoteEscaper = strings.NewReplacer("\\", "\\\\", `"`, "\\\"")
+
+func escapeQuotes(s string) string {
+	return quoteEscaper.Replace(s)
+}
@@ -0,0 +1,185 @@
+package documentstack
+
+import (
+	"context"
+	"errors"
+	"sync"
+)
+
+const defaultBatchConcurrency = 4
+
+// BatchStatus is the outcome of a single batch item.
+type BatchStatus string
+
+// Batch item statuses.
+const (
+	BatchSucceeded BatchStatus = "succeeded"
+	BatchFailed    BatchStatus = "failed"
+	BatchSkipped   BatchStatus = "skipped" // ctx was done before or while the item ran
+)
+
+// BatchItem is a single generation in a batch.
+type BatchItem struct {
+	TemplateID string
+	Request    *GenerateRequest
+}
+
+// BatchItemResult is the outcome of a single batch item.
+type BatchItemResult struct {
+	// Index is the position of the item in the batch.
+	Index int
+
+	// Item is the item that was generated.
+	Item BatchItem
+
+	// Status is the outcome of the item.
+	Status BatchStatus
+
+	// Response is the generated PDF, if the item succeeded, or the
+	// Config.SoftFail placeholder returned with the error of a failed item.
+	Response *GenerateResponse
+
+	// Err is the error, if the item failed or was skipped.
+	Err error
+
+	// Attempts is the number of times the item was run, including RetryFailed
+	// runs. Items skipped before they started do not count.
+	Attempts int
+}
+
+// BatchOptions controls how a batch is generated.
+type BatchOptions struct {
+	// Concurrency is the number of generations run in parallel.
+	// Default: 4
+	Concurrency int
+
+	// DeadLetter, if set, receives each item that failed permanently, i.e.
+	// after the client's retries (Config.MaxRetries) were exhausted or with a
+	// non-retryable error. It is called from worker goroutines, so it must be
+	// safe for concurrent use, and again on every failed RetryFailed run.
+	// Skipped items are not dead-lettered.
+	DeadLetter func(ctx context.Context, item BatchItemResult)
+}
+
+// BatchResult holds the per-item outcomes of a batch.
+type BatchResult struct {
+	// Items are the item results, in the order of the batch.
+	Items []BatchItemResult
+
+	client *Client
+	opts   *BatchOptions
+}
+
+// GenerateBatch generates every item using a pool of concurrent workers and
+// reports the outcome of each item. A failing item does not stop the batch.
+//
+// Example:
+//
+//	result := client.GenerateBatch(ctx, items, nil)
+//	if len(result.Failed()) > 0 {
+//		result.RetryFailed(ctx)
+//	}
+func (c *Client) GenerateBatch(ctx context.Context, items []BatchItem, opts *BatchOptions) *BatchResult {
+	result := &BatchResult{
+		Items:  make([]BatchItemResult, len(items)),
+		client: c,
+		opts:   opts,
+	}
+
+	indexes := make([]int, len(items))
+	for i, item := range items {
+		result.Items[i] = BatchItemResult{Index: i, Item: item}
+		indexes[i] = i
+	}
+
+	result.run(ctx, indexes)
+
+	return result
+}
+
+// Succeeded returns the items that succeeded.
+func (r *BatchResult) Succeeded() []BatchItemResult {
+	return r.filter(func(item BatchItemResult) bool { return item.Status == BatchSucceeded })
+}
+
+// Failed returns the items that failed or were skipped.
+func (r *BatchResult) Failed() []BatchItemResult {
+	return r.filter(func(item BatchItemResult) bool { return item.Status != BatchSucceeded })
+}
+
+// RetryFailed generates the failed and skipped items again and updates their
+// results in place. It returns r for chaining.
+func (r *BatchResult) RetryFailed(ctx context.Context) *BatchResult {
+	var indexes []int
+	for _, item := range r.Failed() {
+		indexes = append(indexes, item.Index)
+	}
+
+	r.run(ctx, indexes)
+
+	return r
+}
+
+func (r *BatchResult) filter(keep func(BatchItemResult) bool) []BatchItemResult {
+	var items []BatchItemResult
+	for _, item := range r.Items {
+		if keep(item) {
+			items = append(items, item)
+		}
+	}
+	return items
+}
+
+// run generates the items at indexes with a pool of workers.
+func (r *BatchResult) run(ctx context.Context, indexes []int) {
+	concurrency := defaultBatchConcurrency
+	if r.opts != nil && r.opts.Concurrency > 0 {
+		concurrency = r.opts.Concurrency
+	}
+
+	work := make(chan int)
+	var wg sync.WaitGroup
+
+	for i := 0; i < concurrency; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for index := range work {
+				r.runItem(ctx, index)
+			}
+		}()
+	}
+
+	for _, index := range indexes {
+		work <- index
+	}
+	close(work)
+	wg.Wait()
+}
+
+// runItem generates a single item and records its outcome.
+func (r *BatchResult) runItem(ctx context.Context, index int) {
+	item := &r.Items[index]
+
+	if err := ctx.Err(); err != nil {
+		item.Status, item.Response, item.Err = BatchSkipped, nil, err
+		return
+	}
+	item.Attempts++
+
+	response, err := r.client.Generate(ctx, item.Item.TemplateID, item.Item.Request)
+	if err != nil && (ctx.Err() != nil || errors.Is(err, ErrClientClosed)) {
+		// Interrupted, not failed: not dead-lettered.
+		item.Status, item.Response, item.Err = BatchSkipped, response, err
+		return
+	}
+	if err != nil {
+		item.Status, item.Response, item.Err = BatchFailed, response, err
+		if r.opts != nil && r.opts.DeadLetter != nil {
+			r.opts.DeadLetter(ctx, *item)
+		}
+		return
+	}
+
+	item.Status, item.Response, item.Err = BatchSucceeded, response, nil
+}
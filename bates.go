@@ -0,0 +1,98 @@
+package documentstack
+
+import "context"
+
+// BatesOptions controls Bates numbering.
+type BatesOptions struct {
+	// Prefix is prepended to each number, e.g. "ACME-".
+	Prefix string `json:"prefix,omitempty"`
+
+	// Suffix is appended to each number.
+	Suffix string `json:"suffix,omitempty"`
+
+	// Start is the first number.
+	// Default: 1
+	Start int `json:"start,omitempty"`
+
+	// Digits is the zero-padded width of the number.
+	// Default: 6
+	Digits int `json:"digits,omitempty"`
+
+	// Position is where the label is placed on each page.
+	// Default: PositionBottomRight
+	Position StampPosition `json:"position,omitempty"`
+
+	// Font is the font family of the label.
+	Font string `json:"font,omitempty"`
+
+	// FontSize is the label size in points.
+	FontSize float64 `json:"fontSize,omitempty"`
+
+	// ExhibitLabels, if set, stamps each document's first page with the
+	// label at the same index, e.g. "Exhibit A".
+	ExhibitLabels []string `json:"exhibitLabels,omitempty"`
+}
+
+// BatesDocument is a numbered document in a BatesResult, the manifest entry
+// for one source document.
+type BatesDocument struct {
+	// Index is the position of the source in the request.
+	Index int `json:"index"`
+
+	// DocumentID is the ID of the stored numbered document.
+	DocumentID string `json:"documentId"`
+
+	// FirstLabel is the label on the first page, e.g. "ACME-000001".
+	FirstLabel string `json:"firstLabel"`
+
+	// LastLabel is the label on the last page.
+	LastLabel string `json:"lastLabel"`
+
+	// PageCount is the number of pages in the document.
+	PageCount int `json:"pageCount"`
+}
+
+// BatesResult is the numbering manifest of a document set.
+type BatesResult struct {
+	// Documents are the numbered documents, in the order of the sources.
+	Documents []BatesDocument `json:"documents"`
+
+	// NextNumber is the number following the last label, for continuing a production.
+	NextNumber int `json:"nextNumber"`
+}
+
+// BatesNumber applies consecutive Bates numbers across all pages of sources,
+// in order, and stores the numbered documents. The returned manifest maps each
+// source to its stored document and label range.
+//
+// Example:
+//
+//	result, err := client.BatesNumber(ctx, sources, &documentstack.BatesOptions{
+//		Prefix: "ACME-",
+//		Start:  1,
+//	})
+//	for _, doc := range result.Documents {
+//		fmt.Printf("%s: %s-%s\n", doc.DocumentID, doc.FirstLabel, doc.LastLabel)
+//	}
+func (c *Client) BatesNumber(ctx context.Context, sources []*Source, opts *BatesOptions) (*BatesResult, error) {
+	if len(sources) == 0 {
+		return nil, NewValidationError("At least one source is required", nil)
+	}
+	for _, source := range sources {
+		if err := source.validate(); err != nil {
+			return nil, err
+		}
+	}
+
+	body := map[string]interface{}{
+		"sources": sources,
+		"options": opts,
+	}
+
+	var result BatesResult
+	if err := c.doJSON(ctx, "POST", "/api/v1/bates", body, &result); err != nil {
+		return nil, err
+	}
+
+	return &result, nil
+}
@@ -0,0 +1,210 @@
+package documentstack
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"go/format"
+	"sort"
+	"strings"
+	"unicode"
+)
+
+// BindingsOptions controls GenerateBindings.
+type BindingsOptions struct {
+	// Package is the package name of the generated file. Required.
+	Package string
+
+	// TemplateIDs are the templates to generate bindings for.
+	// Default: all templates in the workspace
+	TemplateIDs []string
+}
+
+// GenerateBindings fetches template schemas and returns Go source with a data
+// struct and a typed Generate wrapper per template, e.g. InvoiceData and
+// GenerateInvoice(ctx, client, data, options), so data mismatches are caught
+// at compile time. It is used by cmd/documentstack-gen.
+//
+// Example:
+//
+//	src, err := documentstack.GenerateBindings(ctx, client, &documentstack.BindingsOptions{
+//		Package:     "documents",
+//		TemplateIDs: []string{"invoice", "receipt"},
+//	})
+//	if err == nil {
+//		os.WriteFile("documents/templates_gen.go", src, 0o644)
+//	}
+func GenerateBindings(ctx context.Context, client *Client, opts *BindingsOptions) ([]byte, error) {
+	if opts == nil || opts.Package == "" {
+		return nil, NewValidationError("Package name is required", nil)
+	}
+
+	templateIDs := opts.TemplateIDs
+	if len(templateIDs) == 0 {
+		templates, err := client.Templates.listAll(ctx)
+		if err != nil {
+			return nil, err
+		}
+		templateIDs = sortedKeys(templates)
+	}
+
+	schemas := make(map[string]*TemplateSchema, len(templateIDs))
+	for _, templateID := range templateIDs {
+		schema, err := client.Templates.Schema(ctx, templateID)
+		if err != nil {
+			return nil, err
+		}
+		schemas[templateID] = schema
+	}
+
+	return WriteBindings(opts.Package, schemas)
+}
+
+// WriteBindings returns the Go source generated by GenerateBindings for
+// schemas keyed by template ID.
+func WriteBindings(pkg string, schemas map[string]*TemplateSchema) ([]byte, error) {
+	var b bytes.Buffer
+
+	fmt.Fprintf(&b, "// Code generated by documentstack-gen. DO NOT EDIT.\n\n")
+	fmt.Fprintf(&b, "package %s\n\n", pkg)
+	fmt.Fprintf(&b, "import (\n\t\"context\"\n\t\"encoding/json\"\n\n\tdocumentstack \"github.com/documentstack/sdk-go\"\n)\n")
+
+	for _, templateID := range sortedKeys(schemas) {
+		name := goIdentifier(templateID)
+		root := bindingTree(schemas[templateID])
+
+		fmt.Fprintf(&b, "\n// %sData is the data of template %q.\n", name, templateID)
+		writeBindingStruct(&b, name+"Data", root)
+
+		fmt.Fprintf(&b, "\n// Generate%s generates a PDF from template %q.\n", name, templateID)
+		fmt.Fprintf(&b, "func Generate%s(ctx context.Context, client *documentstack.Client, data %sData, options *documentstack.GenerateOptions) (*documentstack.GenerateResponse, error) {\n", name, name)
+		fmt.Fprintf(&b, "\tm, err := bindingData(data)\n\tif err != nil {\n\t\treturn nil, err\n\t}\n")
+		fmt.Fprintf(&b, "\treturn client.Generate(ctx, %q, &documentstack.GenerateRequest{Data: m, Options: options})\n}\n", templateID)
+	}
+
+	fmt.Fprintf(&b, "\n// bindingData converts typed template data to a generation data map.\n")
+	fmt.Fprintf(&b, "func bindingData(data interface{}) (map[string]interface{}, error) {\n")
+	fmt.Fprintf(&b, "\traw, err := json.Marshal(data)\n\tif err != nil {\n\t\treturn nil, err\n\t}\n")
+	fmt.Fprintf(&b, "\tvar m map[string]interface{}\n\terr = json.Unmarshal(raw, &m)\n\treturn m, err\n}\n")
+
+	src, err := format.Source(b.Bytes())
+	if err != nil {
+		return nil, &DocumentStackError{Message: fmt.Sprintf("failed to format generated bindings: %v", err)}
+	}
+	return src, nil
+}
+
+// bindingNode is a variable in the tree built from dotted variable paths.
+type bindingNode struct {
+	variable TemplateVariable
+	children map[string]*bindingNode
+}
+
+// bindingTree arranges the variables of schema by path.
+func bindingTree(schema *TemplateSchema) *bindingNode {
+	root := &bindingNode{children: map[string]*bindingNode{}}
+
+	for _, variable := range schema.Variables {
+		node := root
+		for _, key := range strings.Split(variable.Path, ".") {
+			child, ok := node.children[key]
+			if !ok {
+				child = &bindingNode{
+					variable: TemplateVariable{Path: key, Type: "object"},
+					children: map[string]*bindingNode{},
+				}
+				node.children[key] = child
+			}
+			node = child
+		}
+		node.variable.Type = variable.Type
+		node.variable.Required = variable.Required
+		node.variable.Description = variable.Description
+	}
+
+	return root
+}
+
+// writeBindingStruct writes a struct type for node and, after it, the struct
+// types of its nested objects.
+func writeBindingStruct(b *bytes.Buffer, typeName string, node *bindingNode) {
+	keys := make([]string, 0, len(node.children))
+	for key := range node.children {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	var nested []string
+	fmt.Fprintf(b, "type %s struct {\n", typeName)
+	for _, key := range keys {
+		child := node.children[key]
+		fieldName := goIdentifier(key)
+
+		fieldType := bindingType(child.variable.Type)
+		if len(child.children) > 0 {
+			nested = append(nested, key)
+			fieldType = typeName + fieldName
+			if child.variable.Type == "array" {
+				fieldType = "[]" + fieldType
+			}
+		}
+
+		tag := key
+		if !child.variable.Required {
+			tag += ",omitempty"
+		}
+
+		if child.variable.Description != "" {
+			fmt.Fprintf(b, "\t// %s\n", strings.Join(strings.Fields(child.variable.Description), " "))
+		}
+		fmt.Fprintf(b, "\t%s %s `json:%q`\n", fieldName, fieldType, tag)
+	}
+	fmt.Fprintf(b, "}\n")
+
+	for _, key := range nested {
+		nestedName := typeName + goIdentifier(key)
+		fmt.Fprintf(b, "\n// %s is the %q value of %s.\n", nestedName, key, typeName)
+		writeBindingStruct(b, nestedName, node.children[key])
+	}
+}
+
+// bindingType maps a TemplateVariable type to a Go type.
+func bindingType(variableType string) string {
+	switch variableType {
+	case "string":
+		return "string"
+	case "number":
+		return "float64"
+	case "boolean":
+		return "bool"
+	case "array":
+		return "[]interface{}"
+	case "object":
+		return "map[string]interface{}"
+	}
+	return "interface{}"
+}
+
+// goIdentifier converts a template ID or variable name such as "invoice-v2"
+// or "line_items" to an exported Go identifier.
+func goIdentifier(name string) string {
+	var b strings.Builder
+	upper := true
+	for _, r := range name {
+		if !unicode.IsLetter(r) && !unicode.IsDigit(r) {
+			upper = true
+			continue
+		}
+		if upper {
+			r = unicode.ToUpper(r)
+			upper = false
+		}
+		b.WriteRune(r)
+	}
+
+	identifier := b.String()
+	if identifier == "" || unicode.IsDigit([]rune(identifier)[0]) {
+		identifier = "X" + identifier
+	}
+	return identifier
+}
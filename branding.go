@@ -0,0 +1,105 @@
+package documentstack
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"time"
+)
+
+// BrandingService manages named branding profiles, which let one template
+// render with per-tenant branding. See GenerateOptions.BrandingProfile.
+type BrandingService struct {
+	client *Client
+}
+
+// BrandingProfile is a named set of branding values applied at render time.
+type BrandingProfile struct {
+	// ID is the unique profile identifier, referenced from GenerateOptions.BrandingProfile.
+	ID string `json:"id"`
+
+	// Name is the human-readable profile name.
+	Name string `json:"name"`
+
+	// LogoAssetID is the ID of an uploaded asset used as the logo.
+	LogoAssetID string `json:"logoAssetId,omitempty"`
+
+	// Colors maps color roles such as "primary" and "accent" to CSS colors.
+	Colors map[string]string `json:"colors,omitempty"`
+
+	// Fonts maps font roles such as "heading" and "body" to font family names.
+	Fonts map[string]string `json:"fonts,omitempty"`
+
+	// AddressBlock is the sender address rendered by templates, one line per element.
+	AddressBlock []string `json:"addressBlock,omitempty"`
+
+	// CreatedAt is the time the profile was created. Set by the API.
+	CreatedAt time.Time `json:"createdAt"`
+}
+
+// BrandingProfileList is a page of branding profiles.
+type BrandingProfileList struct {
+	Profiles   []*BrandingProfile `json:"profiles"`
+	NextCursor string             `json:"nextCursor,omitempty"`
+}
+
+// List returns a page of branding profiles.
+func (s *BrandingService) List(ctx context.Context, opts *ListOptions) (*BrandingProfileList, error) {
+	var result BrandingProfileList
+	if err := s.client.doJSON(ctx, "GET", listPath("/api/v1/branding", opts), nil, &result); err != nil {
+		return nil, err
+	}
+
+	return &result, nil
+}
+
+// ListAll returns all branding profiles, fetching every page. See ListAllOptions.
+func (s *BrandingService) ListAll(ctx context.Context, opts *ListAllOptions) ([]*BrandingProfile, error) {
+	return listAll(ctx, opts, func(page *ListOptions) ([]*BrandingProfile, string, error) {
+		result, err := s.List(ctx, page)
+		if err != nil {
+			return nil, "", err
+		}
+		return result.Profiles, result.NextCursor, nil
+	})
+}
+
+// Get retrieves a branding profile.
+func (s *BrandingService) Get(ctx context.Context, profileID string) (*BrandingProfile, error) {
+	if profileID == "" {
+		return nil, NewValidationError("Branding profile ID is required", nil)
+	}
+
+	var result BrandingProfile
+	endpoint := fmt.Sprintf("/api/v1/branding/%s", url.PathEscape(profileID))
+	if err := s.client.doJSON(ctx, "GET", endpoint, nil, &result); err != nil {
+		return nil, err
+	}
+
+	return &result, nil
+}
+
+// Push creates the branding profile or replaces it if a profile with the same ID exists.
+func (s *BrandingService) Push(ctx context.Context, profile *BrandingProfile) (*BrandingProfile, error) {
+	if profile == nil || profile.ID == "" {
+		return nil, NewValidationError("Branding profile ID is required", nil)
+	}
+
+	var result BrandingProfile
+	endpoint := fmt.Sprintf("/api/v1/branding/%s", url.PathEscape(profile.ID))
+	if err := s.client.doJSON(ctx, "PUT", endpoint, profile, &result); err != nil {
+		return nil, err
+	}
+
+	return &result, nil
+}
+
+// Delete deletes a branding profile.
+func (s *BrandingService) Delete(ctx context.Context, profileID string) error {
+	if profileID == "" {
+		return NewValidationError("Branding profile ID is required", nil)
+	}
+
+	endpoint := fmt.Sprintf("/api/v1/branding/%s", url.PathEscape(profileID))
+	return s.client.doJSON(ctx, "DELETE", endpoint, nil, nil)
+}
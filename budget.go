@@ -0,0 +1,110 @@
+package documentstack
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"time"
+)
+
+// BudgetService manages monthly spending limits and budget alerts.
+type BudgetService struct {
+	client *Client
+}
+
+// Budget is a monthly spending limit for the workspace or a single API key.
+// Amounts are in the smallest currency unit, e.g. cents.
+type Budget struct {
+	// APIKeyID scopes the budget to one API key. Empty means the whole workspace.
+	APIKeyID string `json:"apiKeyId,omitempty"`
+
+	// MonthlyLimit is the spending cap per calendar month. Zero removes the cap.
+	MonthlyLimit int64 `json:"monthlyLimit"`
+
+	// Currency is the ISO 4217 currency code of the amounts. Set by the API.
+	Currency string `json:"currency,omitempty"`
+
+	// HardLimit rejects requests once the limit is reached, with a 402 error.
+	// Otherwise the limit only triggers alerts.
+	HardLimit bool `json:"hardLimit"`
+
+	// AlertThresholds are percentages of MonthlyLimit at which alerts are sent, e.g. 50, 80, 100.
+	AlertThresholds []int `json:"alertThresholds,omitempty"`
+
+	// AlertEmails receive budget alerts. Default: workspace owners.
+	AlertEmails []string `json:"alertEmails,omitempty"`
+}
+
+// BudgetUsage is the spending in the current budget period.
+type BudgetUsage struct {
+	APIKeyID    string    `json:"apiKeyId,omitempty"`
+	PeriodStart time.Time `json:"periodStart"`
+	PeriodEnd   time.Time `json:"periodEnd"`
+
+	// Spent is the amount spent so far in this period.
+	Spent int64 `json:"spent"`
+
+	// Projected is the expected spend by the end of the period at the current rate.
+	Projected int64 `json:"projected"`
+
+	// Limit is the MonthlyLimit of the budget, or zero if uncapped.
+	Limit int64 `json:"limit"`
+
+	Currency string `json:"currency"`
+
+	// Generations is the number of generations in this period.
+	Generations int64 `json:"generations"`
+}
+
+func budgetPath(apiKeyID, suffix string) string {
+	if apiKeyID == "" {
+		return "/api/v1/budget" + suffix
+	}
+	return fmt.Sprintf("/api/v1/api-keys/%s/budget%s", url.PathEscape(apiKeyID), suffix)
+}
+
+// Get returns the budget for an API key, or for the workspace if apiKeyID is empty.
+func (s *BudgetService) Get(ctx context.Context, apiKeyID string) (*Budget, error) {
+	var result Budget
+	if err := s.client.doJSON(ctx, "GET", budgetPath(apiKeyID, ""), nil, &result); err != nil {
+		return nil, err
+	}
+
+	return &result, nil
+}
+
+// Set replaces the budget identified by budget.APIKeyID.
+//
+// Example:
+//
+//	_, err := client.Budget.Set(ctx, &documentstack.Budget{
+//		MonthlyLimit:    500_00,
+//		HardLimit:       true,
+//		AlertThresholds: []int{50, 80, 100},
+//	})
+func (s *BudgetService) Set(ctx context.Context, budget *Budget) (*Budget, error) {
+	if budget == nil {
+		return nil, NewValidationError("Budget is required", nil)
+	}
+	if budget.MonthlyLimit < 0 {
+		return nil, NewValidationError("Monthly limit must not be negative", nil)
+	}
+
+	var result Budget
+	if err := s.client.doJSON(ctx, "PUT", budgetPath(budget.APIKeyID, ""), budget, &result); err != nil {
+		return nil, err
+	}
+
+	return &result, nil
+}
+
+// Usage returns the spending in the current period for an API key, or for the
+// workspace if apiKeyID is empty.
+func (s *BudgetService) Usage(ctx context.Context, apiKeyID string) (*BudgetUsage, error) {
+	var result BudgetUsage
+	if err := s.client.doJSON(ctx, "GET", budgetPath(apiKeyID, "/usage"), nil, &result); err != nil {
+		return nil, err
+	}
+
+	return &result, nil
+}
@@ -0,0 +1,123 @@
+package documentstack
+
+import (
+	"bytes"
+	"context"
+	"sync"
+)
+
+// GenerateInto is like Generate but reads the PDF into buf instead of a newly
+// allocated slice, to reduce garbage during bulk generation. buf is reset
+// first.
+//
+// The returned GenerateResponse.PDF aliases buf's contents: it remains valid
+// only until buf is next modified, reset or returned to a pool, and the caller
+// must copy it to keep it longer. Config.Deduplicate does not apply.
+//
+// Example:
+//
+//	pool := documentstack.NewBufferPool(0)
+//	for _, data := range invoices {
+//		buf := pool.Get()
+//		response, err := client.GenerateInto(ctx, "invoice", &documentstack.GenerateRequest{Data: data}, buf)
+//		if err == nil {
+//			err = upload(ctx, response.PDF)
+//		}
+//		pool.Put(buf)
+//		...
+//	}
+func (c *Client) GenerateInto(ctx context.Context, templateID string, request *GenerateRequest, buf *bytes.Buffer) (*GenerateResponse, error) {
+	if buf == nil {
+		return nil, NewValidationError("Buffer is required", nil)
+	}
+
+	stream, err := c.GenerateStream(ctx, templateID, request)
+	if err == nil {
+		var response *GenerateResponse
+		if response, err = readStreamInto(stream, buf); err == nil {
+			return response, nil
+		}
+	}
+
+	response, err := c.fallback(ctx, templateID, request, err)
+	if response == nil {
+		return nil, err
+	}
+	// With Config.SoftFail, the placeholder is returned with the error.
+	return fallbackInto(response, buf), err
+}
+
+// GenerateInto is like Generate but reads the PDF into buf, with the same
+// ownership rules as Client.GenerateInto.
+func (p *PreparedRequest) GenerateInto(ctx context.Context, data map[string]interface{}, buf *bytes.Buffer) (*GenerateResponse, error) {
+	if buf == nil {
+		return nil, NewValidationError("Buffer is required", nil)
+	}
+
+	stream, err := p.GenerateStream(ctx, data)
+	if err == nil {
+		var response *GenerateResponse
+		if response, err = readStreamInto(stream, buf); err == nil {
+			return response, nil
+		}
+	}
+
+	request := p.template
+	request.Data = data
+	response, err := p.client.fallback(ctx, p.templateID, &request, err)
+	if response == nil {
+		return nil, err
+	}
+	return fallbackInto(response, buf), err
+}
+
+// fallbackInto copies a fallback PDF into buf, so that the PDF of a
+// GenerateInto response always aliases buf.
+func fallbackInto(response *GenerateResponse, buf *bytes.Buffer) *GenerateResponse {
+	buf.Reset()
+	buf.Write(response.PDF)
+	response.PDF = buf.Bytes()
+	return response
+}
+
+// defaultMaxPooledPDFSize is the default BufferPool size limit.
+const defaultMaxPooledPDFSize = 16 << 20
+
+// BufferPool is a pool of buffers for GenerateInto. Buffers grown beyond the
+// pool's size limit are dropped on Put instead of being kept. A BufferPool is
+// safe for concurrent use.
+type BufferPool struct {
+	maxSize int
+	pool    sync.Pool
+}
+
+// NewBufferPool creates a buffer pool that keeps buffers of at most maxSize
+// bytes of capacity.
+// Default maxSize: 16 MiB
+func NewBufferPool(maxSize int) *BufferPool {
+	if maxSize <= 0 {
+		maxSize = defaultMaxPooledPDFSize
+	}
+	return &BufferPool{
+		maxSize: maxSize,
+		pool: sync.Pool{New: func() interface{} {
+			return new(bytes.Buffer)
+		}},
+	}
+}
+
+// Get returns an empty buffer from the pool, or a new one.
+func (p *BufferPool) Get() *bytes.Buffer {
+	buf := p.pool.Get().(*bytes.Buffer)
+	buf.Reset()
+	return buf
+}
+
+// Put returns buf to the pool. buf, and any PDF read into it, must not be used
+// afterwards.
+func (p *BufferPool) Put(buf *bytes.Buffer) {
+	if buf == nil || buf.Cap() > p.maxSize {
+		return
+	}
+	p.pool.Put(buf)
+}
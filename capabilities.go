@@ -0,0 +1,179 @@
+package documentstack
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// Feature is an account feature that must be enabled to use some API methods.
+type Feature string
+
+const (
+	// FeatureSigning enables e-signature envelopes (SignaturesService.Send).
+	FeatureSigning Feature = "signing"
+
+	// FeatureOCR enables data extraction from scanned documents (ExtractRaw and Extract).
+	FeatureOCR Feature = "ocr"
+
+	// FeaturePrint enables print-and-mail (PrintService.Submit).
+	FeaturePrint Feature = "print"
+)
+
+// capabilitiesTTL is how long capabilities are cached for feature checks.
+const capabilitiesTTL = 5 * time.Minute
+
+// Headers carrying capabilities on every API response.
+const (
+	featuresHeader   = "X-Features"
+	maxPayloadHeader = "X-Max-Payload"
+)
+
+// Capabilities describes the features enabled for the account.
+type Capabilities struct {
+	// Features are the enabled features.
+	Features []Feature `json:"features"`
+
+	// MaxPayloadSize is the largest accepted request body in bytes, or zero
+	// if unknown.
+	MaxPayloadSize int64 `json:"maxPayloadSize,omitempty"`
+}
+
+// Has reports whether feature is enabled.
+func (c *Capabilities) Has(feature Feature) bool {
+	for _, enabled := range c.Features {
+		if enabled == feature {
+			return true
+		}
+	}
+	return false
+}
+
+// capabilitiesCache caches the account capabilities for feature checks.
+type capabilitiesCache struct {
+	mu        sync.Mutex
+	value     *Capabilities
+	fetchedAt time.Time
+}
+
+// store caches capabilities, keeping a known MaxPayloadSize if it is unset.
+func (c *capabilitiesCache) store(capabilities *Capabilities) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if capabilities.MaxPayloadSize == 0 && c.value != nil {
+		stored := *capabilities
+		stored.MaxPayloadSize = c.value.MaxPayloadSize
+		capabilities = &stored
+	}
+	c.value = capabilities
+	c.fetchedAt = time.Now()
+}
+
+// observe updates the cache from the capability headers of any response, so
+// that feature checks rarely need to call the API.
+func (c *capabilitiesCache) observe(header http.Header) {
+	features, hasFeatures := header[featuresHeader]
+	maxPayload, err := strconv.ParseInt(header.Get(maxPayloadHeader), 10, 64)
+	hasMaxPayload := err == nil && maxPayload > 0
+	if !hasFeatures && !hasMaxPayload {
+		return
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	updated := &Capabilities{}
+	if c.value != nil {
+		*updated = *c.value
+	}
+	if hasMaxPayload {
+		updated.MaxPayloadSize = maxPayload
+	}
+	if hasFeatures {
+		updated.Features = nil
+		for _, value := range features {
+			for _, feature := range strings.Split(value, ",") {
+				if feature = strings.TrimSpace(feature); feature != "" {
+					updated.Features = append(updated.Features, Feature(feature))
+				}
+			}
+		}
+		c.fetchedAt = time.Now()
+	}
+	c.value = updated
+}
+
+// Capabilities retrieves the features enabled for the account.
+//
+// Methods that need a feature check the capabilities, cached for five minutes
+// and refreshed from the headers of every response, and return a
+// *FeatureNotEnabledError without calling the API when the feature is not
+// enabled.
+func (c *Client) Capabilities(ctx context.Context) (*Capabilities, error) {
+	var result Capabilities
+	if err := c.doJSON(ctx, "GET", "/api/v1/capabilities", nil, &result); err != nil {
+		return nil, err
+	}
+
+	c.capabilities.store(&result)
+
+	return &result, nil
+}
+
+// CachedCapabilities returns the capabilities last retrieved with
+// Capabilities or reported in the X-Features and X-Max-Payload headers of any
+// API response, without calling the API. It returns false if none are cached
+// yet. Workers sharing a client can use it instead of each calling
+// Capabilities.
+func (c *Client) CachedCapabilities() (*Capabilities, bool) {
+	c.capabilities.mu.Lock()
+	defer c.capabilities.mu.Unlock()
+
+	if c.capabilities.value == nil {
+		return nil, false
+	}
+	capabilities := *c.capabilities.value
+	capabilities.Features = append([]Feature(nil), capabilities.Features...)
+	return &capabilities, true
+}
+
+// MaxPayloadSize returns the largest request body the API accepts, in bytes,
+// as last reported by the API, or zero if unknown.
+func (c *Client) MaxPayloadSize() int64 {
+	c.capabilities.mu.Lock()
+	defer c.capabilities.mu.Unlock()
+
+	if c.capabilities.value == nil {
+		return 0
+	}
+	return c.capabilities.value.MaxPayloadSize
+}
+
+// requireFeature returns a *FeatureNotEnabledError if feature is not enabled.
+// If the capabilities cannot be retrieved, the check is skipped and the API
+// decides.
+func (c *Client) requireFeature(ctx context.Context, feature Feature) error {
+	c.capabilities.mu.Lock()
+	capabilities := c.capabilities.value
+	if capabilities != nil && time.Since(c.capabilities.fetchedAt) > capabilitiesTTL {
+		capabilities = nil
+	}
+	c.capabilities.mu.Unlock()
+
+	if capabilities == nil {
+		var err error
+		capabilities, err = c.Capabilities(ctx)
+		if err != nil {
+			return nil
+		}
+	}
+
+	if !capabilities.Has(feature) {
+		return &FeatureNotEnabledError{Feature: feature}
+	}
+	return nil
+}
@@ -0,0 +1,48 @@
+package documentstack
+
+import "context"
+
+// Classification is a predicted document type.
+type Classification struct {
+	// Label is the predicted label.
+	Label string `json:"label"`
+
+	// Confidence is the prediction confidence between 0 and 1.
+	Confidence float64 `json:"confidence"`
+}
+
+// ClassifyResult is the outcome of document classification.
+type ClassifyResult struct {
+	Classification
+
+	// Candidates are all labels with their confidence, highest first.
+	Candidates []Classification `json:"candidates"`
+}
+
+// Classify predicts which of labels describes the document, e.g. "invoice",
+// "contract" or "receipt". If labels is empty, the API's built-in document
+// types are used.
+//
+// Example:
+//
+//	result, err := client.Classify(ctx, documentstack.SourceFromBytes(upload), []string{"invoice", "receipt", "contract"})
+//	if err == nil && result.Confidence > 0.8 {
+//		route(result.Label)
+//	}
+func (c *Client) Classify(ctx context.Context, source *Source, labels []string) (*ClassifyResult, error) {
+	if err := source.validate(); err != nil {
+		return nil, err
+	}
+
+	body := map[string]interface{}{
+		"source": source,
+		"labels": labels,
+	}
+
+	var result ClassifyResult
+	if err := c.queryJSON(ctx, "/api/v1/classify", body, &result); err != nil {
+		return nil, err
+	}
+
+	return &result, nil
+}
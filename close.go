@@ -0,0 +1,102 @@
+package documentstack
+
+import (
+	"context"
+	"sync"
+)
+
+// ErrClientClosed is returned by calls started after Client.Close.
+var ErrClientClosed = &DocumentStackError{Message: "client is closed"}
+
+// lifecycle tracks in-flight calls and background workers so that Close can
+// drain them. It is shared by a client and the clients derived from it.
+type lifecycle struct {
+	mu       sync.Mutex
+	closed   bool
+	inflight sync.WaitGroup
+
+	// closing is canceled when Close is called, to stop background workers
+	// from starting new work.
+	closing     context.Context
+	stopClosing context.CancelFunc
+
+	// aborted is canceled when Close gives up waiting, to cancel in-flight calls.
+	aborted context.Context
+	abort   context.CancelFunc
+}
+
+func newLifecycle() *lifecycle {
+	l := &lifecycle{}
+	l.closing, l.stopClosing = context.WithCancel(context.Background())
+	l.aborted, l.abort = context.WithCancel(context.Background())
+	return l
+}
+
+// begin registers an in-flight call and returns a context that is canceled
+// with ctx or when Close aborts, and a function that must be called once the
+// call has finished.
+func (l *lifecycle) begin(ctx context.Context) (context.Context, func(), error) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if l.closed {
+		return nil, nil, ErrClientClosed
+	}
+	l.inflight.Add(1)
+
+	ctx, cancel := context.WithCancel(ctx)
+	stop := context.AfterFunc(l.aborted, cancel)
+
+	var once sync.Once
+	return ctx, func() {
+		once.Do(func() {
+			stop()
+			cancel()
+			l.inflight.Done()
+		})
+	}, nil
+}
+
+// Close stops the client from accepting new calls, waits for in-flight calls
+// and background workers such as Queue.Run and JobManager.Run to finish, and
+// closes idle connections. If ctx is done first, in-flight calls are canceled
+// and ctx.Err() is returned without waiting for them to return.
+//
+// Clients derived with Clone and WithTenant are closed with the client. Calls
+// made after Close return ErrClientClosed. Clones with their own Transport or
+// Timeouts.Connect keep their idle connections.
+//
+// Example:
+//
+//	ctx, cancel := context.WithTimeout(context.Background(), 25*time.Second)
+//	defer cancel()
+//	if err := client.Close(ctx); err != nil {
+//		log.Printf("documentstack: in-flight calls canceled: %v", err)
+//	}
+func (c *Client) Close(ctx context.Context) error {
+	l := c.lifecycle
+
+	l.mu.Lock()
+	l.closed = true
+	l.mu.Unlock()
+	l.stopClosing()
+
+	drained := make(chan struct{})
+	go func() {
+		l.inflight.Wait()
+		close(drained)
+	}()
+
+	var err error
+	select {
+	case <-drained:
+	case <-ctx.Done():
+		// Calls holding an unclosed StreamResponse or RawResponse body would
+		// block a wait for drained indefinitely.
+		l.abort()
+		err = ctx.Err()
+	}
+
+	c.httpClient.CloseIdleConnections()
+	return err
+}
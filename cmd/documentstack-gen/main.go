@@ -0,0 +1,57 @@
+// Command documentstack-gen generates typed Go bindings for DocumentStack
+// templates: a data struct and a Generate wrapper per template.
+//
+// Usage:
+//
+//	//go:generate go run github.com/documentstack/sdk-go/cmd/documentstack-gen -package documents -templates invoice,receipt -out templates_gen.go
+//
+// The API key is read from the DOCUMENTSTACK_API_KEY environment variable and
+// the optional base URL from DOCUMENTSTACK_BASE_URL.
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"os"
+	"strings"
+
+	documentstack "github.com/documentstack/sdk-go"
+)
+
+func main() {
+	pkg := flag.String("package", os.Getenv("GOPACKAGE"), "package name of the generated file")
+	templates := flag.String("templates", "", "comma-separated template IDs (default: all templates)")
+	out := flag.String("out", "templates_gen.go", "output file")
+	flag.Parse()
+
+	if err := run(*pkg, *templates, *out); err != nil {
+		fmt.Fprintf(os.Stderr, "documentstack-gen: %v\n", err)
+		os.Exit(1)
+	}
+}
+
+func run(pkg, templates, out string) error {
+	client, err := documentstack.New(documentstack.Config{
+		APIKey:  os.Getenv("DOCUMENTSTACK_API_KEY"),
+		BaseURL: os.Getenv("DOCUMENTSTACK_BASE_URL"),
+	})
+	if err != nil {
+		return err
+	}
+
+	var templateIDs []string
+	if templates != "" {
+		templateIDs = strings.Split(templates, ",")
+	}
+
+	src, err := documentstack.GenerateBindings(context.Background(), client, &documentstack.BindingsOptions{
+		Package:     pkg,
+		TemplateIDs: templateIDs,
+	})
+	if err != nil {
+		return err
+	}
+
+	return os.WriteFile(out, src, 0o644)
+}
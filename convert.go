@@ -0,0 +1,186 @@
+package documentstack
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+)
+
+// Viewport is the browser viewport size in CSS pixels.
+type Viewport struct {
+	Width  int `json:"width"`
+	Height int `json:"height"`
+}
+
+// URLConvertOptions controls how a web page is rendered to PDF.
+type URLConvertOptions struct {
+	// Filename is the custom filename for the PDF (without .pdf extension).
+	Filename string `json:"filename,omitempty"`
+
+	// Viewport is the browser viewport.
+	// Default: 1280x800
+	Viewport *Viewport `json:"viewport,omitempty"`
+
+	// WaitForSelector delays rendering until an element matching this CSS selector exists.
+	WaitForSelector string `json:"waitForSelector,omitempty"`
+
+	// WaitMs is an additional delay in milliseconds before rendering.
+	WaitMs int `json:"waitMs,omitempty"`
+
+	// Headers are sent with the page request, e.g. for authentication.
+	Headers map[string]string `json:"headers,omitempty"`
+
+	// Cookies are set before the page is loaded.
+	Cookies []*http.Cookie `json:"-"`
+
+	// DisableJavaScript renders the page with JavaScript disabled.
+	DisableJavaScript bool `json:"disableJavaScript,omitempty"`
+
+	// PrintBackground includes background colors and images.
+	PrintBackground bool `json:"printBackground,omitempty"`
+}
+
+// cookie is the wire form of an http.Cookie.
+type cookie struct {
+	Name     string `json:"name"`
+	Value    string `json:"value"`
+	Domain   string `json:"domain,omitempty"`
+	Path     string `json:"path,omitempty"`
+	Secure   bool   `json:"secure,omitempty"`
+	HTTPOnly bool   `json:"httpOnly,omitempty"`
+}
+
+// ConvertURL renders a live web page to PDF, e.g. for archiving checkout
+// confirmations. opts may be nil.
+//
+// Example:
+//
+//	result, err := client.ConvertURL(ctx, "https://shop.example.com/orders/4711/receipt", &documentstack.URLConvertOptions{
+//		WaitForSelector: "#receipt",
+//		Cookies:         []*http.Cookie{{Name: "session", Value: sessionID}},
+//	})
+func (c *Client) ConvertURL(ctx context.Context, pageURL string, opts *URLConvertOptions) (*GenerateResponse, error) {
+	if pageURL == "" {
+		return nil, NewValidationError("URL is required", nil)
+	}
+	if opts == nil {
+		opts = &URLConvertOptions{}
+	}
+
+	cookies := make([]cookie, 0, len(opts.Cookies))
+	for _, ck := range opts.Cookies {
+		cookies = append(cookies, cookie{
+			Name:     ck.Name,
+			Value:    ck.Value,
+			Domain:   ck.Domain,
+			Path:     ck.Path,
+			Secure:   ck.Secure,
+			HTTPOnly: ck.HttpOnly,
+		})
+	}
+
+	body := struct {
+		URL string `json:"url"`
+		*URLConvertOptions
+		Cookies []cookie `json:"cookies,omitempty"`
+	}{
+		URL:               pageURL,
+		URLConvertOptions: opts,
+		Cookies:           cookies,
+	}
+
+	return c.postPDF(ctx, "/api/v1/convert/url", body)
+}
+
+// MarkdownConvertOptions controls how Markdown is rendered to PDF.
+type MarkdownConvertOptions struct {
+	// Filename is the custom filename for the PDF (without .pdf extension).
+	Filename string `json:"filename,omitempty"`
+
+	// Theme is the name of the document theme, e.g. "report" or "release-notes".
+	// Default: "default"
+	Theme string `json:"theme,omitempty"`
+
+	// BrandingProfile is the ID of the branding profile to render with.
+	BrandingProfile string `json:"brandingProfile,omitempty"`
+}
+
+// ConvertMarkdown renders GitHub-flavored Markdown to a PDF. opts may be nil.
+//
+// Example:
+//
+//	result, err := client.ConvertMarkdown(ctx, releaseNotes, &documentstack.MarkdownConvertOptions{
+//		Theme:           "release-notes",
+//		BrandingProfile: "acme",
+//	})
+func (c *Client) ConvertMarkdown(ctx context.Context, markdown string, opts *MarkdownConvertOptions) (*GenerateResponse, error) {
+	if markdown == "" {
+		return nil, NewValidationError("Markdown is required", nil)
+	}
+
+	body := struct {
+		Markdown string `json:"markdown"`
+		*MarkdownConvertOptions
+	}{
+		Markdown:               markdown,
+		MarkdownConvertOptions: opts,
+	}
+
+	return c.postPDF(ctx, "/api/v1/convert/markdown", body)
+}
+
+// OfficeFormat is the format of an Office document.
+type OfficeFormat string
+
+// Office document formats.
+const (
+	FormatDOCX OfficeFormat = "docx"
+	FormatXLSX OfficeFormat = "xlsx"
+	FormatPPTX OfficeFormat = "pptx"
+	FormatODT  OfficeFormat = "odt"
+)
+
+var officeContentTypes = map[OfficeFormat]string{
+	FormatDOCX: "application/vnd.openxmlformats-officedocument.wordprocessingml.document",
+	FormatXLSX: "application/vnd.openxmlformats-officedocument.spreadsheetml.sheet",
+	FormatPPTX: "application/vnd.openxmlformats-officedocument.presentationml.presentation",
+	FormatODT:  "application/vnd.oasis.opendocument.text",
+}
+
+// ConvertOffice converts an Office document read from r to PDF. The document
+// is streamed to the API and the PDF is streamed back; the caller must close
+// StreamResponse.Body. Since r cannot be replayed, the call is not retried.
+//
+// Example:
+//
+//	f, _ := os.Open("contract.docx")
+//	defer f.Close()
+//
+//	stream, err := client.ConvertOffice(ctx, f, documentstack.FormatDOCX)
+//	if err != nil {
+//		return err
+//	}
+//	defer stream.Body.Close()
+func (c *Client) ConvertOffice(ctx context.Context, r io.Reader, format OfficeFormat) (*StreamResponse, error) {
+	contentType, ok := officeContentTypes[format]
+	if !ok {
+		return nil, NewValidationError(fmt.Sprintf("Unsupported Office format %q", format), nil)
+	}
+
+	ctx = withOperation(ctx, operationGenerate)
+
+	path := "/api/v1/convert/office?format=" + url.QueryEscape(string(format))
+	req, err := c.newRequest(ctx, "POST", path, r, contentType)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := c.send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	return c.streamResponse(resp)
+}
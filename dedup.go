@@ -0,0 +1,78 @@
+package documentstack
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"sync"
+)
+
+// flightGroup collapses concurrent identical Generate calls into one request.
+type flightGroup struct {
+	mu    sync.Mutex
+	calls map[string]*flightCall
+}
+
+type flightCall struct {
+	done   chan struct{}
+	result *GenerateResponse
+	err    error
+}
+
+// do runs fn once for all concurrent callers with the same key. Callers that
+// join an in-flight call stop waiting when their own ctx is done, but the
+// call itself runs with the first caller's ctx.
+func (g *flightGroup) do(ctx context.Context, key string, fn func() (*GenerateResponse, error)) (*GenerateResponse, error) {
+	g.mu.Lock()
+	if g.calls == nil {
+		g.calls = make(map[string]*flightCall)
+	}
+	if call, ok := g.calls[key]; ok {
+		g.mu.Unlock()
+
+		select {
+		case <-call.done:
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		}
+
+		if call.result == nil {
+			return nil, call.err
+		}
+		shared := *call.result
+		return &shared, call.err
+	}
+
+	call := &flightCall{done: make(chan struct{})}
+	g.calls[key] = call
+	g.mu.Unlock()
+
+	call.result, call.err = fn()
+
+	g.mu.Lock()
+	delete(g.calls, key)
+	g.mu.Unlock()
+	close(call.done)
+
+	return call.result, call.err
+}
+
+// generateKey identifies a generation by the customer it is made on behalf
+// of, template and request payload. Calls for different customers are never
+// shared, as the shared call carries the first caller's X-On-Behalf-Of header.
+func generateKey(onBehalfOf, templateID string, request *GenerateRequest) (string, error) {
+	body, err := json.Marshal(request)
+	if err != nil {
+		return "", err
+	}
+
+	hash := sha256.New()
+	hash.Write([]byte(onBehalfOf))
+	hash.Write([]byte{0})
+	hash.Write([]byte(templateID))
+	hash.Write([]byte{0})
+	hash.Write(body)
+
+	return hex.EncodeToString(hash.Sum(nil)), nil
+}
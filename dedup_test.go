@@ -0,0 +1,44 @@
+package documentstack
+
+import (
+	"context"
+	"net/http"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestGenerateDeduplicateSeparatesOnBehalfOf(t *testing.T) {
+	var calls atomic.Int32
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		calls.Add(1)
+		time.Sleep(100 * time.Millisecond)
+		writePDF(w)
+	})
+	client.config.Deduplicate = true
+
+	generate := func(customers ...string) int32 {
+		calls.Store(0)
+		var wg sync.WaitGroup
+		for _, customer := range customers {
+			ctx := WithOnBehalfOf(context.Background(), customer)
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				if _, err := client.Generate(ctx, "invoice", &GenerateRequest{Data: map[string]interface{}{"n": 1}}); err != nil {
+					t.Errorf("Generate: %v", err)
+				}
+			}()
+		}
+		wg.Wait()
+		return calls.Load()
+	}
+
+	if got := generate("cus_a", "cus_a"); got != 1 {
+		t.Errorf("same customer: got %d API calls, want 1", got)
+	}
+	if got := generate("cus_a", "cus_b"); got != 2 {
+		t.Errorf("different customers: got %d API calls, want 2", got)
+	}
+}
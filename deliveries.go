@@ -0,0 +1,201 @@
+package documentstack
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"time"
+)
+
+// DestinationType is the kind of delivery destination.
+type DestinationType string
+
+// Delivery destination types.
+const (
+	DestinationS3    DestinationType = "s3"
+	DestinationEmail DestinationType = "email"
+	DestinationHTTP  DestinationType = "http"
+	DestinationSFTP  DestinationType = "sftp"
+
+	// DestinationGoogleDrive delivers to a Google Drive folder.
+	DestinationGoogleDrive DestinationType = "google_drive"
+
+	// DestinationSharePoint delivers to a SharePoint document library or OneDrive.
+	DestinationSharePoint DestinationType = "sharepoint"
+)
+
+// DeliveriesService manages destinations generated documents are delivered to.
+type DeliveriesService struct {
+	client *Client
+}
+
+// Destination is a place generated documents are delivered to.
+type Destination struct {
+	// ID is the unique destination identifier. Set by the API.
+	ID string `json:"id,omitempty"`
+
+	// Name is the unique human-readable destination name.
+	Name string `json:"name"`
+
+	// Type is the destination type.
+	Type DestinationType `json:"type"`
+
+	// Config is the type-specific destination configuration.
+	Config map[string]interface{} `json:"config"`
+
+	// CreatedAt is the time the destination was created. Set by the API.
+	CreatedAt time.Time `json:"createdAt"`
+}
+
+// DestinationList is a page of delivery destinations.
+type DestinationList struct {
+	Destinations []*Destination `json:"destinations"`
+	NextCursor   string         `json:"nextCursor,omitempty"`
+}
+
+// List returns a page of delivery destinations.
+func (s *DeliveriesService) List(ctx context.Context, opts *ListOptions) (*DestinationList, error) {
+	var result DestinationList
+	if err := s.client.doJSON(ctx, "GET", listPath("/api/v1/destinations", opts), nil, &result); err != nil {
+		return nil, err
+	}
+
+	return &result, nil
+}
+
+// ListAll returns all delivery destinations, fetching every page. See ListAllOptions.
+func (s *DeliveriesService) ListAll(ctx context.Context, opts *ListAllOptions) ([]*Destination, error) {
+	return listAll(ctx, opts, func(page *ListOptions) ([]*Destination, string, error) {
+		result, err := s.List(ctx, page)
+		if err != nil {
+			return nil, "", err
+		}
+		return result.Destinations, result.NextCursor, nil
+	})
+}
+
+// Get retrieves a delivery destination.
+func (s *DeliveriesService) Get(ctx context.Context, destinationID string) (*Destination, error) {
+	if destinationID == "" {
+		return nil, NewValidationError("Destination ID is required", nil)
+	}
+
+	var result Destination
+	endpoint := fmt.Sprintf("/api/v1/destinations/%s", url.PathEscape(destinationID))
+	if err := s.client.doJSON(ctx, "GET", endpoint, nil, &result); err != nil {
+		return nil, err
+	}
+
+	return &result, nil
+}
+
+// Create creates a delivery destination.
+func (s *DeliveriesService) Create(ctx context.Context, destination *Destination) (*Destination, error) {
+	if destination == nil || destination.Name == "" {
+		return nil, NewValidationError("Destination name is required", nil)
+	}
+	if err := destination.validate(); err != nil {
+		return nil, err
+	}
+
+	var result Destination
+	if err := s.client.doJSON(ctx, "POST", "/api/v1/destinations", destination, &result); err != nil {
+		return nil, err
+	}
+
+	return &result, nil
+}
+
+// Update replaces the configuration of an existing delivery destination.
+func (s *DeliveriesService) Update(ctx context.Context, destinationID string, destination *Destination) (*Destination, error) {
+	if destinationID == "" {
+		return nil, NewValidationError("Destination ID is required", nil)
+	}
+	if destination != nil {
+		if err := destination.validate(); err != nil {
+			return nil, err
+		}
+	}
+
+	var result Destination
+	endpoint := fmt.Sprintf("/api/v1/destinations/%s", url.PathEscape(destinationID))
+	if err := s.client.doJSON(ctx, "PUT", endpoint, destination, &result); err != nil {
+		return nil, err
+	}
+
+	return &result, nil
+}
+
+// Delete deletes a delivery destination.
+func (s *DeliveriesService) Delete(ctx context.Context, destinationID string) error {
+	if destinationID == "" {
+		return NewValidationError("Destination ID is required", nil)
+	}
+
+	endpoint := fmt.Sprintf("/api/v1/destinations/%s", url.PathEscape(destinationID))
+	return s.client.doJSON(ctx, "DELETE", endpoint, nil, nil)
+}
+
+// destinationConfig is a typed destination configuration.
+type destinationConfig interface {
+	validate() error
+}
+
+// newTypedDestination validates config and stores it as the destination's Config.
+func newTypedDestination(name string, destinationType DestinationType, config destinationConfig) (*Destination, error) {
+	if config == nil {
+		return nil, NewValidationError("Destination config is required", nil)
+	}
+	if err := config.validate(); err != nil {
+		return nil, err
+	}
+
+	data, err := json.Marshal(config)
+	if err != nil {
+		return nil, &DocumentStackError{Message: fmt.Sprintf("failed to encode destination config: %v", err)}
+	}
+
+	var m map[string]interface{}
+	if err := json.Unmarshal(data, &m); err != nil {
+		return nil, &DocumentStackError{Message: fmt.Sprintf("failed to encode destination config: %v", err)}
+	}
+
+	return &Destination{Name: name, Type: destinationType, Config: m}, nil
+}
+
+// decodeConfig decodes Config into config, which must be of destinationType.
+func (d *Destination) decodeConfig(destinationType DestinationType, config interface{}) error {
+	if d.Type != destinationType {
+		return &DocumentStackError{Message: fmt.Sprintf("destination %q is of type %q, not %q", d.Name, d.Type, destinationType)}
+	}
+
+	data, err := json.Marshal(d.Config)
+	if err != nil {
+		return &DocumentStackError{Message: fmt.Sprintf("failed to decode destination config: %v", err)}
+	}
+	if err := json.Unmarshal(data, config); err != nil {
+		return &DocumentStackError{Message: fmt.Sprintf("failed to decode destination config: %v", err)}
+	}
+	return nil
+}
+
+// validate checks the configuration of destination types with typed configs.
+func (d *Destination) validate() error {
+	var config destinationConfig
+	switch d.Type {
+	case DestinationSFTP:
+		config = &SFTPConfig{}
+	case DestinationGoogleDrive:
+		config = &GoogleDriveConfig{}
+	case DestinationSharePoint:
+		config = &SharePointConfig{}
+	default:
+		return nil
+	}
+
+	if err := d.decodeConfig(d.Type, config); err != nil {
+		return NewValidationError(err.Error(), nil)
+	}
+	return config.validate()
+}
@@ -0,0 +1,144 @@
+package documentstack
+
+import (
+	"fmt"
+	"strings"
+)
+
+// ConflictPolicy decides what happens when a file with the same name already
+// exists in the destination folder.
+type ConflictPolicy string
+
+// Conflict policies.
+const (
+	// ConflictRename adds a numeric suffix, e.g. "proposal (2).pdf".
+	ConflictRename ConflictPolicy = "rename"
+
+	// ConflictOverwrite replaces the existing file, as a new version where supported.
+	ConflictOverwrite ConflictPolicy = "overwrite"
+
+	// ConflictSkip keeps the existing file and drops the delivery.
+	ConflictSkip ConflictPolicy = "skip"
+)
+
+// GoogleDriveConfig is the configuration of a Google Drive destination.
+type GoogleDriveConfig struct {
+	// ConnectionRef is the ID of the Google account connection authorized in
+	// the workspace settings.
+	ConnectionRef string `json:"connectionRef"`
+
+	// FolderID is the ID of the target folder, from its URL.
+	FolderID string `json:"folderId"`
+
+	// SharedDriveID is the ID of the shared drive containing FolderID, if any.
+	SharedDriveID string `json:"sharedDriveId,omitempty"`
+
+	// NamePattern is the file name template, e.g. "Proposal {documentId}.pdf".
+	// It supports the same placeholders as SFTPConfig.Path.
+	// Default: "{filename}"
+	NamePattern string `json:"namePattern,omitempty"`
+
+	// Default: ConflictRename
+	ConflictPolicy ConflictPolicy `json:"conflictPolicy,omitempty"`
+}
+
+// SharePointConfig is the configuration of a SharePoint or OneDrive destination.
+type SharePointConfig struct {
+	// ConnectionRef is the ID of the Microsoft 365 connection authorized in
+	// the workspace settings.
+	ConnectionRef string `json:"connectionRef"`
+
+	// SiteID is the SharePoint site. Empty targets the OneDrive of the
+	// connected account.
+	SiteID string `json:"siteId,omitempty"`
+
+	// DriveID is the document library. Empty uses the site's default library.
+	DriveID string `json:"driveId,omitempty"`
+
+	// FolderPath is the target folder within the drive, e.g. "/Sales/Proposals".
+	FolderPath string `json:"folderPath"`
+
+	// NamePattern is the file name template. It supports the same
+	// placeholders as SFTPConfig.Path.
+	// Default: "{filename}"
+	NamePattern string `json:"namePattern,omitempty"`
+
+	// Default: ConflictRename
+	ConflictPolicy ConflictPolicy `json:"conflictPolicy,omitempty"`
+}
+
+// NewGoogleDriveDestination creates a validated Google Drive destination for
+// DeliveriesService.Create.
+func NewGoogleDriveDestination(name string, config *GoogleDriveConfig) (*Destination, error) {
+	return newTypedDestination(name, DestinationGoogleDrive, config)
+}
+
+// NewSharePointDestination creates a validated SharePoint or OneDrive
+// destination for DeliveriesService.Create.
+//
+// Example:
+//
+//	destination, err := documentstack.NewSharePointDestination("sales-proposals", &documentstack.SharePointConfig{
+//		ConnectionRef:  "conn_123",
+//		SiteID:         "contoso.sharepoint.com,2C712604-1370-44E7-A1F5-426573FDA80A,2D2244C3-251A-49EA-93A8-39E1C3A060FE",
+//		FolderPath:     "/Sales/Proposals",
+//		NamePattern:    "Proposal {documentId}.pdf",
+//		ConflictPolicy: documentstack.ConflictOverwrite,
+//	})
+func NewSharePointDestination(name string, config *SharePointConfig) (*Destination, error) {
+	return newTypedDestination(name, DestinationSharePoint, config)
+}
+
+// GoogleDriveConfig decodes the configuration of a Google Drive destination.
+func (d *Destination) GoogleDriveConfig() (*GoogleDriveConfig, error) {
+	var config GoogleDriveConfig
+	if err := d.decodeConfig(DestinationGoogleDrive, &config); err != nil {
+		return nil, err
+	}
+	return &config, nil
+}
+
+// SharePointConfig decodes the configuration of a SharePoint or OneDrive destination.
+func (d *Destination) SharePointConfig() (*SharePointConfig, error) {
+	var config SharePointConfig
+	if err := d.decodeConfig(DestinationSharePoint, &config); err != nil {
+		return nil, err
+	}
+	return &config, nil
+}
+
+func (c *GoogleDriveConfig) validate() error {
+	if c.ConnectionRef == "" {
+		return NewValidationError("Google Drive connection reference is required", nil)
+	}
+	if c.FolderID == "" {
+		return NewValidationError("Google Drive folder ID is required", nil)
+	}
+	return validateNaming(c.NamePattern, c.ConflictPolicy)
+}
+
+func (c *SharePointConfig) validate() error {
+	if c.ConnectionRef == "" {
+		return NewValidationError("SharePoint connection reference is required", nil)
+	}
+	if !strings.HasPrefix(c.FolderPath, "/") {
+		return NewValidationError("SharePoint folder path must be absolute", nil)
+	}
+	return validateNaming(c.NamePattern, c.ConflictPolicy)
+}
+
+// validateNaming checks a file name pattern and conflict policy.
+func validateNaming(namePattern string, policy ConflictPolicy) error {
+	if strings.ContainsAny(namePattern, `/\`) {
+		return NewValidationError("Name pattern must not contain path separators", nil)
+	}
+	if err := validatePathTemplate(namePattern); err != nil {
+		return err
+	}
+
+	switch policy {
+	case "", ConflictRename, ConflictOverwrite, ConflictSkip:
+		return nil
+	}
+	return NewValidationError(fmt.Sprintf("Invalid conflict policy %q", policy), nil)
+}
@@ -0,0 +1,102 @@
+package documentstack
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+// deliveryPathPlaceholders are the placeholders allowed in delivery path templates.
+var deliveryPathPlaceholders = map[string]bool{
+	"templateId": true,
+	"documentId": true,
+	"filename":   true,
+	"date":       true,
+	"timestamp":  true,
+}
+
+var placeholderPattern = regexp.MustCompile(`\{([^{}]*)\}`)
+
+// SFTPConfig is the configuration of an SFTP destination.
+type SFTPConfig struct {
+	Host string `json:"host"`
+
+	// Port is the SSH port.
+	// Default: 22
+	Port int `json:"port,omitempty"`
+
+	Username string `json:"username"`
+
+	// HostKeyFingerprint pins the server's host key, in the OpenSSH SHA256
+	// format, e.g. "SHA256:nThbg6kXUpJWGl7E1IGOCspRomTxdCARLviKw6E5SY8".
+	// Connections to a server presenting another key are refused.
+	HostKeyFingerprint string `json:"hostKeyFingerprint"`
+
+	// PrivateKeyRef is the ID of an SSH private key stored in the workspace
+	// vault. The key itself is never sent through this API.
+	PrivateKeyRef string `json:"privateKeyRef"`
+
+	// Path is the remote file path template, e.g.
+	// "/inbound/{date}/{filename}". Supported placeholders are {templateId},
+	// {documentId}, {filename}, {date} (YYYY-MM-DD) and {timestamp} (Unix seconds).
+	Path string `json:"path"`
+}
+
+// NewSFTPDestination creates a validated SFTP destination for DeliveriesService.Create.
+//
+// Example:
+//
+//	destination, err := documentstack.NewSFTPDestination("acme-sftp", &documentstack.SFTPConfig{
+//		Host:               "sftp.acme.example",
+//		Username:           "documents",
+//		HostKeyFingerprint: "SHA256:nThbg6kXUpJWGl7E1IGOCspRomTxdCARLviKw6E5SY8",
+//		PrivateKeyRef:      "key_123",
+//		Path:               "/inbound/{date}/{filename}",
+//	})
+//	if err == nil {
+//		destination, err = client.Deliveries.Create(ctx, destination)
+//	}
+func NewSFTPDestination(name string, config *SFTPConfig) (*Destination, error) {
+	return newTypedDestination(name, DestinationSFTP, config)
+}
+
+// SFTPConfig decodes the configuration of an SFTP destination.
+func (d *Destination) SFTPConfig() (*SFTPConfig, error) {
+	var config SFTPConfig
+	if err := d.decodeConfig(DestinationSFTP, &config); err != nil {
+		return nil, err
+	}
+	return &config, nil
+}
+
+func (c *SFTPConfig) validate() error {
+	if c.Host == "" {
+		return NewValidationError("SFTP host is required", nil)
+	}
+	if c.Port < 0 || c.Port > 65535 {
+		return NewValidationError(fmt.Sprintf("Invalid SFTP port %d", c.Port), nil)
+	}
+	if c.Username == "" {
+		return NewValidationError("SFTP username is required", nil)
+	}
+	if !strings.HasPrefix(c.HostKeyFingerprint, "SHA256:") {
+		return NewValidationError("SFTP host key fingerprint must be in SHA256:... format", nil)
+	}
+	if c.PrivateKeyRef == "" {
+		return NewValidationError("SFTP private key reference is required", nil)
+	}
+	if !strings.HasPrefix(c.Path, "/") {
+		return NewValidationError("SFTP path must be absolute", nil)
+	}
+	return validatePathTemplate(c.Path)
+}
+
+// validatePathTemplate checks that path only uses supported placeholders.
+func validatePathTemplate(path string) error {
+	for _, match := range placeholderPattern.FindAllStringSubmatch(path, -1) {
+		if !deliveryPathPlaceholders[match[1]] {
+			return NewValidationError(fmt.Sprintf("Unsupported path placeholder {%s}", match[1]), nil)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,284 @@
+package documentstack
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"net/url"
+	"time"
+)
+
+// DocumentsService manages documents stored in DocumentStack, such as
+// generations made with GenerateOptions.Store.
+type DocumentsService struct {
+	client *Client
+}
+
+// Document is a document stored in DocumentStack.
+type Document struct {
+	ID string `json:"id"`
+
+	// TemplateID is the template the document was generated from, if any.
+	TemplateID string `json:"templateId,omitempty"`
+
+	Filename  string `json:"filename"`
+	Size      int64  `json:"size"`
+	PageCount int    `json:"pageCount"`
+
+	// Checksum is the hex-encoded SHA-256 of the document content. See DocumentChecksum.
+	Checksum string `json:"checksum,omitempty"`
+
+	// Duplicate is set on an upload response when the upload was skipped
+	// because this document has identical content. See DocumentMetadata.SkipDuplicates.
+	Duplicate bool `json:"duplicate,omitempty"`
+
+	// Tags are the tags of the generation (GenerateOptions.Tags), as updated
+	// with UpdateTags.
+	Tags map[string]string `json:"tags,omitempty"`
+
+	// OnBehalfOf is the customer ID the document was generated for. See WithOnBehalfOf.
+	OnBehalfOf string `json:"onBehalfOf,omitempty"`
+
+	// RetainUntil is when the document will be deleted by a retention
+	// policy, if any applies.
+	RetainUntil *time.Time `json:"retainUntil,omitempty"`
+
+	// LegalHold reports whether a legal hold prevents deletion. See RetentionService.PlaceHold.
+	LegalHold bool `json:"legalHold,omitempty"`
+
+	// DeletedAt is the time the document was moved to the trash, and PurgeAt
+	// the time it will be permanently deleted, for documents in the trash.
+	DeletedAt *time.Time `json:"deletedAt,omitempty"`
+	PurgeAt   *time.Time `json:"purgeAt,omitempty"`
+
+	CreatedAt time.Time `json:"createdAt"`
+}
+
+// DocumentList is a page of documents.
+type DocumentList struct {
+	Documents  []*Document `json:"documents"`
+	NextCursor string      `json:"nextCursor,omitempty"`
+}
+
+// DocumentSearch filters and paginates stored documents. All set filters must match.
+type DocumentSearch struct {
+	ListOptions
+
+	// Query is matched against the document text and filename, e.g. "invoice 4711".
+	Query string
+
+	// TemplateID limits results to documents generated from this template.
+	TemplateID string
+
+	// Tags limits results to documents with all of these tags.
+	Tags map[string]string
+
+	// Checksum limits results to documents with this content checksum.
+	Checksum string
+
+	// From and To limit results to documents created in [From, To). Zero values are unbounded.
+	From time.Time
+	To   time.Time
+}
+
+func (s *DocumentSearch) values() url.Values {
+	if s == nil {
+		return url.Values{}
+	}
+
+	values := s.ListOptions.values()
+	if s.Query != "" {
+		values.Set("q", s.Query)
+	}
+	if s.TemplateID != "" {
+		values.Set("templateId", s.TemplateID)
+	}
+	for _, key := range sortedKeys(s.Tags) {
+		values.Add("tag", key+":"+s.Tags[key])
+	}
+	if s.Checksum != "" {
+		values.Set("checksum", s.Checksum)
+	}
+	if !s.From.IsZero() {
+		values.Set("from", s.From.UTC().Format(time.RFC3339))
+	}
+	if !s.To.IsZero() {
+		values.Set("to", s.To.UTC().Format(time.RFC3339))
+	}
+	return values
+}
+
+// Search returns a page of stored documents matching search, most recent first.
+//
+// Example:
+//
+//	documents, err := client.Documents.Search(ctx, &documentstack.DocumentSearch{
+//		Query: "4711",
+//		Tags:  map[string]string{"customer": "9"},
+//	})
+func (s *DocumentsService) Search(ctx context.Context, search *DocumentSearch) (*DocumentList, error) {
+	endpoint := "/api/v1/documents"
+	if query := search.values().Encode(); query != "" {
+		endpoint += "?" + query
+	}
+
+	var result DocumentList
+	if err := s.client.doJSON(ctx, "GET", endpoint, nil, &result); err != nil {
+		return nil, err
+	}
+
+	return &result, nil
+}
+
+// SearchAll returns all stored documents matching search, fetching every
+// page. The pagination fields of search are ignored. See ListAllOptions.
+func (s *DocumentsService) SearchAll(ctx context.Context, search *DocumentSearch, opts *ListAllOptions) ([]*Document, error) {
+	var filter DocumentSearch
+	if search != nil {
+		filter = *search
+	}
+
+	return listAll(ctx, opts, func(page *ListOptions) ([]*Document, string, error) {
+		filter.ListOptions = *page
+		result, err := s.Search(ctx, &filter)
+		if err != nil {
+			return nil, "", err
+		}
+		return result.Documents, result.NextCursor, nil
+	})
+}
+
+// FindByChecksum returns the stored documents whose content has the given
+// checksum, e.g. to check whether a PDF is already stored before uploading it.
+//
+// Example:
+//
+//	existing, err := client.Documents.FindByChecksum(ctx, documentstack.DocumentChecksum(pdf))
+func (s *DocumentsService) FindByChecksum(ctx context.Context, checksum string) ([]*Document, error) {
+	if checksum == "" {
+		return nil, NewValidationError("Checksum is required", nil)
+	}
+
+	return collectPages(func(opts *ListOptions) ([]*Document, string, error) {
+		result, err := s.Search(ctx, &DocumentSearch{ListOptions: *opts, Checksum: checksum})
+		if err != nil {
+			return nil, "", err
+		}
+		return result.Documents, result.NextCursor, nil
+	})
+}
+
+// DocumentChecksum returns the checksum of document content as used by
+// Document.Checksum: the hex-encoded SHA-256.
+func DocumentChecksum(content []byte) string {
+	sum := sha256.Sum256(content)
+	return hex.EncodeToString(sum[:])
+}
+
+// Get retrieves the metadata of a stored document.
+func (s *DocumentsService) Get(ctx context.Context, documentID string) (*Document, error) {
+	if documentID == "" {
+		return nil, NewValidationError("Document ID is required", nil)
+	}
+
+	var result Document
+	endpoint := fmt.Sprintf("/api/v1/documents/%s", url.PathEscape(documentID))
+	if err := s.client.doJSON(ctx, "GET", endpoint, nil, &result); err != nil {
+		return nil, err
+	}
+
+	return &result, nil
+}
+
+// Download fetches the PDF of a stored document.
+func (s *DocumentsService) Download(ctx context.Context, documentID string) (*GenerateResponse, error) {
+	if documentID == "" {
+		return nil, NewValidationError("Document ID is required", nil)
+	}
+
+	return s.client.getPDF(ctx, fmt.Sprintf("/api/v1/documents/%s/content", url.PathEscape(documentID)))
+}
+
+// DownloadTo downloads the PDF of a stored document into w in parallel
+// ranged segments, for very large documents, and returns its size. w is
+// typically an *os.File; segments are written at their offsets in any order.
+//
+// Example:
+//
+//	f, err := os.Create("archive.pdf")
+//	if err != nil {
+//		return err
+//	}
+//	defer f.Close()
+//	_, err = client.Documents.DownloadTo(ctx, documentID, f, &documentstack.SegmentedDownloadOptions{Concurrency: 8})
+func (s *DocumentsService) DownloadTo(ctx context.Context, documentID string, w io.WriterAt, opts *SegmentedDownloadOptions) (int64, error) {
+	if documentID == "" {
+		return 0, NewValidationError("Document ID is required", nil)
+	}
+
+	return s.client.downloadSegmented(ctx, fmt.Sprintf("/api/v1/documents/%s/content", url.PathEscape(documentID)), w, opts)
+}
+
+// UpdateTags replaces the tags of a stored document.
+func (s *DocumentsService) UpdateTags(ctx context.Context, documentID string, tags map[string]string) (*Document, error) {
+	if documentID == "" {
+		return nil, NewValidationError("Document ID is required", nil)
+	}
+
+	var result Document
+	endpoint := fmt.Sprintf("/api/v1/documents/%s/tags", url.PathEscape(documentID))
+	if err := s.client.doJSON(ctx, "PUT", endpoint, map[string]interface{}{"tags": tags}, &result); err != nil {
+		return nil, err
+	}
+
+	return &result, nil
+}
+
+// Delete moves a stored document to the trash. It can be restored with
+// Restore until it is purged, automatically after a retention period or with
+// Purge. Documents under a legal hold cannot be deleted.
+func (s *DocumentsService) Delete(ctx context.Context, documentID string) error {
+	if documentID == "" {
+		return NewValidationError("Document ID is required", nil)
+	}
+
+	endpoint := fmt.Sprintf("/api/v1/documents/%s", url.PathEscape(documentID))
+	return s.client.doJSON(ctx, "DELETE", endpoint, nil, nil)
+}
+
+// ListDeleted returns a page of documents in the trash.
+func (s *DocumentsService) ListDeleted(ctx context.Context, opts *ListOptions) (*DocumentList, error) {
+	var result DocumentList
+	if err := s.client.doJSON(ctx, "GET", listPath("/api/v1/documents/trash", opts), nil, &result); err != nil {
+		return nil, err
+	}
+
+	return &result, nil
+}
+
+// Restore moves a document out of the trash.
+func (s *DocumentsService) Restore(ctx context.Context, documentID string) (*Document, error) {
+	if documentID == "" {
+		return nil, NewValidationError("Document ID is required", nil)
+	}
+
+	var result Document
+	endpoint := fmt.Sprintf("/api/v1/documents/trash/%s/restore", url.PathEscape(documentID))
+	if err := s.client.doJSON(ctx, "POST", endpoint, nil, &result); err != nil {
+		return nil, err
+	}
+
+	return &result, nil
+}
+
+// Purge permanently deletes a document in the trash. It cannot be restored.
+func (s *DocumentsService) Purge(ctx context.Context, documentID string) error {
+	if documentID == "" {
+		return NewValidationError("Document ID is required", nil)
+	}
+
+	endpoint := fmt.Sprintf("/api/v1/documents/trash/%s", url.PathEscape(documentID))
+	return s.client.doJSON(ctx, "DELETE", endpoint, nil, nil)
+}
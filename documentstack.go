@@ -2,29 +2,102 @@
 package documentstack
 
 import (
+	"bufio"
 	"bytes"
+	"compress/gzip"
 	"context"
 	"encoding/json"
 	"fmt"
 	"io"
 	"log"
+	"mime"
 	"net/http"
 	"net/url"
 	"regexp"
 	"strconv"
 	"strings"
-	"time"
+	"sync"
+	"unicode/utf8"
 )
 
 const (
 	defaultBaseURL = "https://api.documentstack.dev"
 	defaultTimeout = 30
+
+	tenantHeader         = "X-Tenant-ID"
+	requestIDHeader      = "X-Request-ID"
+	idempotencyKeyHeader = "Idempotency-Key"
 )
 
 // Client is the DocumentStack API client.
+//
+// A Client is safe for concurrent use by multiple goroutines. Its configuration
+// cannot change after New; use Clone to derive a client with different settings.
 type Client struct {
-	config     Config
-	httpClient *http.Client
+	config       Config
+	httpClient   *http.Client
+	flights      *flightGroup
+	capabilities *capabilitiesCache
+	lifecycle    *lifecycle
+	clock        *serverClock
+	deprecations *sync.Map // deprecation warnings already logged
+
+	// Templates manages templates in the workspace.
+	Templates *TemplatesService
+
+	// Assets manages uploaded assets such as images and fonts.
+	Assets *AssetsService
+
+	// Webhooks manages webhook endpoints.
+	Webhooks *WebhooksService
+
+	// APIKeys manages API keys and their scopes.
+	APIKeys *APIKeysService
+
+	// Deliveries manages delivery destinations.
+	Deliveries *DeliveriesService
+
+	// Schedules manages recurring server-side generation jobs.
+	Schedules *SchedulesService
+
+	// Partials manages shared partials such as headers and footers.
+	Partials *PartialsService
+
+	// Branding manages per-tenant branding profiles.
+	Branding *BrandingService
+
+	// Print submits documents for print-and-mail.
+	Print *PrintService
+
+	// Signatures sends documents for e-signature.
+	Signatures *SignaturesService
+
+	// Analytics reports document views and downloads.
+	Analytics *AnalyticsService
+
+	// Budget manages spending limits and budget alerts.
+	Budget *BudgetService
+
+	// Jobs submits and tracks asynchronous generation jobs.
+	Jobs *JobsService
+
+	// Usage reports generation usage and cost.
+	Usage *UsageService
+
+	// Rules manages server-side event routing rules.
+	Rules *RulesService
+
+	// Documents manages stored documents.
+	Documents *DocumentsService
+
+	// Retention manages document retention policies and legal holds.
+	Retention *RetentionService
+
+	// EncryptionKeys manages customer-managed encryption keys (BYOK).
+	EncryptionKeys *EncryptionKeysService
+
+	// Export exports stored documents in bulk.
+	Export *ExportService
 }
 
 // New creates a new DocumentStack client with the given configuration.
@@ -53,8 +126,85 @@ type Client struct {
 //
 //	os.WriteFile("invoice.pdf", result.PDF, 0644)
 func New(config Config) (*Client, error) {
+	config, err := normalizeConfig(config)
+	if err != nil {
+		return nil, err
+	}
+
+	return newClient(config, newHTTPClient(config), newLifecycle()), nil
+}
+
+// NewDownloadOnly creates a client restricted to read and download
+// operations (GET requests), for deployments holding download-only API keys.
+// Any other call, including Generate, fails locally with a forbidden error
+// before a request is sent. Clients derived with Clone or WithTenant keep the
+// restriction.
+func NewDownloadOnly(config Config) (*Client, error) {
+	config.downloadOnly = true
+	return New(config)
+}
+
+// Clone returns a new client whose configuration is a copy of c's with override
+// applied. The clone shares c's transport and connection pool, so cloning is
+// cheap and suitable for per-tenant variants, unless override changes
+// Transport or Timeouts.Connect, which give the clone its own HTTP client.
+// override may be nil.
+//
+// Example:
+//
+//	tenantClient, err := client.Clone(func(config *documentstack.Config) {
+//		config.APIKey = tenant.APIKey
+//		config.Headers["X-Tenant-ID"] = tenant.ID
+//	})
+func (c *Client) Clone(override func(config *Config)) (*Client, error) {
+	config := c.config
+	config.Headers = make(map[string]string, len(c.config.Headers))
+	for key, value := range c.config.Headers {
+		config.Headers[key] = value
+	}
+
+	if override != nil {
+		override(&config)
+	}
+
+	config, err := normalizeConfig(config)
+	if err != nil {
+		return nil, err
+	}
+
+	httpClient := c.httpClient
+	if !sameTransport(config.Transport, c.config.Transport) || connectTimeout(config) != connectTimeout(c.config) {
+		httpClient = newHTTPClient(config)
+	}
+
+	return newClient(config, httpClient, c.lifecycle), nil
+}
+
+// WithTenant returns a lightweight client for a tenant that shares c's
+// transport and connection pool. The tenant ID is sent in the X-Tenant-ID
+// header; if apiKey is non-empty it replaces c's API key.
+//
+// Example:
+//
+//	invoice, err := client.WithTenant(tenant.ID, tenant.APIKey).Generate(ctx, "invoice", request)
+func (c *Client) WithTenant(tenantID, apiKey string) *Client {
+	// Clone cannot fail here: the API key is only replaced with a non-empty one.
+	clone, _ := c.Clone(func(config *Config) {
+		if apiKey != "" {
+			config.APIKey = apiKey
+		}
+		if tenantID != "" {
+			config.Headers[tenantHeader] = tenantID
+		}
+	})
+	return clone
+}
+
+// normalizeConfig validates config and applies defaults. The returned config
+// does not share its Headers map with the caller's.
+func normalizeConfig(config Config) (Config, error) {
 	if config.APIKey == "" {
-		return nil, &DocumentStackError{Message: "API key is required"}
+		return config, &DocumentStackError{Message: "API key is required"}
 	}
 
 	if config.BaseURL == "" {
@@ -66,18 +216,48 @@ func New(config Config) (*Client, error) {
 		config.Timeout = defaultTimeout
 	}
 
-	if config.Headers == nil {
-		config.Headers = make(map[string]string)
+	headers := make(map[string]string, len(config.Headers))
+	for key, value := range config.Headers {
+		headers[key] = value
 	}
+	config.Headers = headers
+
+	return config, nil
+}
 
+// newClient creates a client and its services from a normalized config.
+// Clients sharing httpClient must share lc.
+func newClient(config Config, httpClient *http.Client, lc *lifecycle) *Client {
 	client := &Client{
-		config: config,
-		httpClient: &http.Client{
-			Timeout: time.Duration(config.Timeout) * time.Second,
-		},
+		config:       config,
+		httpClient:   httpClient,
+		flights:      &flightGroup{},
+		capabilities: &capabilitiesCache{},
+		lifecycle:    lc,
+		clock:        &serverClock{},
+		deprecations: &sync.Map{},
 	}
+	client.Templates = &TemplatesService{client: client}
+	client.Assets = &AssetsService{client: client}
+	client.Webhooks = &WebhooksService{client: client}
+	client.APIKeys = &APIKeysService{client: client}
+	client.Deliveries = &DeliveriesService{client: client}
+	client.Schedules = &SchedulesService{client: client}
+	client.Partials = &PartialsService{client: client}
+	client.Branding = &BrandingService{client: client}
+	client.Print = &PrintService{client: client}
+	client.Signatures = &SignaturesService{client: client}
+	client.Analytics = &AnalyticsService{client: client}
+	client.Budget = &BudgetService{client: client}
+	client.Jobs = &JobsService{client: client}
+	client.Usage = &UsageService{client: client}
+	client.Rules = &RulesService{client: client}
+	client.Documents = &DocumentsService{client: client}
+	client.Retention = &RetentionService{client: client}
+	client.EncryptionKeys = &EncryptionKeysService{client: client}
+	client.Export = &ExportService{client: client}
 
-	return client, nil
+	return client
 }
 
 // Generate generates a PDF from a template.
@@ -88,95 +268,336 @@ func New(config Config) (*Client, error) {
 //   - request: Generation request with data and options (can be nil)
 //
 // Returns the generated PDF and metadata, or an error.
+//
+// With Config.FallbackRenderer, transient failures that remain after retries
+// are rendered locally and the response has Fallback set.
+//
+// With Config.SoftFail, some failures return a placeholder PDF together with
+// the error; see SoftFailConfig.
+//
+// With Config.Deduplicate, concurrent calls with the same template, request
+// and WithOnBehalfOf ID share a single API call and the same PDF slice,
+// which must not be modified.
 func (c *Client) Generate(ctx context.Context, templateID string, request *GenerateRequest) (*GenerateResponse, error) {
+	if c.config.Deduplicate {
+		key, err := generateKey(onBehalfOf(ctx), templateID, request)
+		if err != nil {
+			return nil, &NetworkError{Message: "failed to marshal request body", Cause: err}
+		}
+
+		return c.flights.do(ctx, key, func() (*GenerateResponse, error) {
+			return c.generate(ctx, templateID, request)
+		})
+	}
+
+	return c.generate(ctx, templateID, request)
+}
+
+// generate performs a single Generate call.
+func (c *Client) generate(ctx context.Context, templateID string, request *GenerateRequest) (*GenerateResponse, error) {
+	stream, err := c.GenerateStream(ctx, templateID, request)
+	if err != nil {
+		return c.fallback(ctx, templateID, request, err)
+	}
+
+	response, err := readStream(stream)
+	if err != nil {
+		return c.fallback(ctx, templateID, request, err)
+	}
+
+	return response, nil
+}
+
+// GenerateStream generates a PDF from a template and returns the response body
+// unread, so the PDF can be piped to its destination without buffering it in memory.
+//
+// The caller must close StreamResponse.Body.
+//
+// Example:
+//
+//	stream, err := client.GenerateStream(ctx, "template-id", request)
+//	if err != nil {
+//		return err
+//	}
+//	defer stream.Body.Close()
+//
+//	_, err = io.Copy(w, stream.Body)
+func (c *Client) GenerateStream(ctx context.Context, templateID string, request *GenerateRequest) (*StreamResponse, error) {
 	if templateID == "" {
 		return nil, NewValidationError("Template ID is required", nil)
 	}
 
+	request, err := c.prepareRequest(templateID, request)
+	if err != nil {
+		return nil, err
+	}
+
+	path := fmt.Sprintf("/api/v1/generate/%s", url.PathEscape(templateID))
+	return c.postStream(ctx, path, request)
+}
+
+// prepareRequest validates request and returns the request to send, with
+// its data prepared by prepareData.
+func (c *Client) prepareRequest(templateID string, request *GenerateRequest) (*GenerateRequest, error) {
 	if request == nil {
 		request = &GenerateRequest{}
 	}
 
-	endpoint := fmt.Sprintf("%s/api/v1/generate/%s", c.config.BaseURL, url.PathEscape(templateID))
+	if err := request.Options.validate(); err != nil {
+		return nil, err
+	}
 
-	body, err := json.Marshal(request)
+	if request.Data != nil {
+		data, err := c.prepareData(templateID, request.Data)
+		if err != nil {
+			return nil, err
+		}
+		prepared := *request
+		prepared.Data = data
+		request = &prepared
+	}
+
+	return request, nil
+}
+
+// prepareData returns data with Config.Migrations, Config.Transforms and
+// Config.FieldEncryption applied. data is not modified.
+func (c *Client) prepareData(templateID string, data map[string]interface{}) (map[string]interface{}, error) {
+	if migrations, ok := c.config.Migrations[templateID]; ok {
+		data = migrations.Apply(data)
+	}
+
+	if len(c.config.Transforms) > 0 {
+		data = copyData(data)
+		for _, transform := range c.config.Transforms {
+			data = transform(data)
+		}
+	}
+
+	if c.config.FieldEncryption != nil {
+		return c.config.FieldEncryption.encrypt(data)
+	}
+
+	return data, nil
+}
+
+// postStream posts payload as JSON to an endpoint that responds with a
+// document and returns the unread response. It is retried like an idempotent
+// request; retries carry the same Idempotency-Key, so that side effects such
+// as GenerateOptions.Store and CallbackURL happen once. See sendRetryable.
+func (c *Client) postStream(ctx context.Context, path string, payload interface{}) (*StreamResponse, error) {
+	ctx = withOperation(ctx, operationGenerate)
+
+	body, err := json.Marshal(payload)
 	if err != nil {
 		return nil, &NetworkError{Message: "failed to marshal request body", Cause: err}
 	}
 
 	if c.config.Debug {
-		log.Printf("[DocumentStack] Request: POST %s\n", endpoint)
-		log.Printf("[DocumentStack] Body: %s\n", string(body))
+		log.Printf("[DocumentStack] Body: %s\n", redactJSON(body, defaultScrubbedFields))
 	}
 
-	req, err := http.NewRequestWithContext(ctx, "POST", endpoint, bytes.NewReader(body))
+	req, err := c.newRequest(ctx, "POST", path, bytes.NewReader(body), "application/json")
 	if err != nil {
-		return nil, &NetworkError{Message: "failed to create request", Cause: err}
+		return nil, err
 	}
 
-	req.Header.Set("Content-Type", "application/json")
-	req.Header.Set("Authorization", fmt.Sprintf("Bearer %s", c.config.APIKey))
-
-	for key, value := range c.config.Headers {
-		req.Header.Set(key, value)
+	resp, err := c.sendRetryable(ctx, req)
+	if err != nil {
+		return nil, err
 	}
 
-	resp, err := c.httpClient.Do(req)
+	return c.streamResponse(resp)
+}
+
+// postPDF is like postStream but reads the whole document.
+func (c *Client) postPDF(ctx context.Context, path string, payload interface{}) (*GenerateResponse, error) {
+	stream, err := c.postStream(ctx, path, payload)
 	if err != nil {
-		if ctx.Err() == context.DeadlineExceeded {
-			return nil, &TimeoutError{Timeout: c.config.Timeout}
-		}
-		return nil, &NetworkError{Message: "request failed", Cause: err}
+		return nil, err
 	}
-	defer resp.Body.Close()
 
-	if resp.StatusCode != http.StatusOK {
-		return nil, c.parseErrorResponse(resp)
-	}
+	return readStream(stream)
+}
 
-	pdf, err := io.ReadAll(resp.Body)
+// streamResponse extracts document metadata from a successful response. It
+// returns an *UnexpectedContentError, and closes the body, if the response is
+// not a PDF.
+func (c *Client) streamResponse(resp *http.Response) (*StreamResponse, error) {
+	body, err := pdfBody(resp)
 	if err != nil {
-		return nil, &NetworkError{Message: "failed to read response body", Cause: err}
+		resp.Body.Close()
+		return nil, err
 	}
 
 	// Extract metadata from headers
-	contentDisposition := resp.Header.Get("Content-Disposition")
 	generationTimeMs, _ := strconv.ParseInt(resp.Header.Get("X-Generation-Time-Ms"), 10, 64)
-	contentLength, _ := strconv.ParseInt(resp.Header.Get("Content-Length"), 10, 64)
+	queueWaitMs, _ := strconv.ParseInt(resp.Header.Get("X-Queue-Wait-Ms"), 10, 64)
 
 	// Parse filename from Content-Disposition
 	filename := "document.pdf"
-	re := regexp.MustCompile(`filename="?([^";\n]+)"?`)
-	if matches := re.FindStringSubmatch(contentDisposition); len(matches) > 1 {
+	if matches := filenamePattern.FindStringSubmatch(resp.Header.Get("Content-Disposition")); len(matches) > 1 {
 		filename = matches[1]
 	}
 
-	if contentLength == 0 {
-		contentLength = int64(len(pdf))
+	if c.config.Debug {
+		log.Printf("[DocumentStack] Response: filename=%s, time=%dms, size=%d\n", filename, generationTimeMs, resp.ContentLength)
 	}
 
-	if c.config.Debug {
-		log.Printf("[DocumentStack] Response: filename=%s, time=%dms, size=%d\n", filename, generationTimeMs, contentLength)
+	return &StreamResponse{
+		Body:             body,
+		ContentType:      resp.Header.Get("Content-Type"),
+		Filename:         filename,
+		GenerationTimeMs: generationTimeMs,
+		QueueWaitMs:      queueWaitMs,
+		Region:           resp.Header.Get("X-Region"),
+		ContentLength:    resp.ContentLength,
+		Variant:          resp.Header.Get("X-Experiment-Variant"),
+		DocumentID:       resp.Header.Get("X-Document-ID"),
+		Duplicate:        resp.Header.Get("X-Document-Duplicate") == "true",
+		Warnings:         c.responseWarnings(resp),
+		Trace:            c.responseTrace(resp),
+	}, nil
+}
+
+// pdfMagic is the signature at the start of every PDF file.
+var pdfMagic = []byte("%PDF-")
+
+// pdfBody returns the body of resp, decompressed if the server compressed it
+// unasked, after checking that it is a PDF. Intermediaries such as captive
+// portals and misconfigured proxies may answer with an HTML page and HTTP 200.
+func pdfBody(resp *http.Response) (io.ReadCloser, error) {
+	var reader io.Reader = resp.Body
+	if strings.EqualFold(resp.Header.Get("Content-Encoding"), "gzip") && !resp.Uncompressed {
+		gz, err := gzip.NewReader(resp.Body)
+		if err != nil {
+			return nil, &NetworkError{Message: "failed to decompress response body", Cause: err}
+		}
+		reader = gz
+		resp.ContentLength = -1
+	}
+
+	buffered := bufio.NewReader(reader)
+	head, _ := buffered.Peek(len(pdfMagic))
+
+	contentType := resp.Header.Get("Content-Type")
+	mediaType, _, _ := mime.ParseMediaType(contentType)
+	if (mediaType != "" && mediaType != "application/pdf") || !bytes.Equal(head, pdfMagic) {
+		snippet, _ := io.ReadAll(io.LimitReader(buffered, maxRawBodySnippet))
+		return nil, &UnexpectedContentError{
+			StatusCode:  resp.StatusCode,
+			ContentType: contentType,
+			Snippet:     bodySnippet(snippet),
+		}
+	}
+
+	return readCloser{Reader: buffered, Closer: resp.Body}, nil
+}
+
+// readCloser combines a reader with the closer of the underlying body.
+type readCloser struct {
+	io.Reader
+	io.Closer
+}
+
+// readStream reads and closes stream.
+func readStream(stream *StreamResponse) (*GenerateResponse, error) {
+	return readStreamInto(stream, nil)
+}
+
+// readStreamInto reads and closes stream. If buf is non-nil, it is reset and
+// the PDF is read into it, and the returned PDF aliases its contents.
+func readStreamInto(stream *StreamResponse, buf *bytes.Buffer) (*GenerateResponse, error) {
+	defer stream.Body.Close()
+
+	var pdf []byte
+	var err error
+	if buf == nil {
+		pdf, err = io.ReadAll(stream.Body)
+	} else {
+		buf.Reset()
+		if stream.ContentLength > 0 {
+			buf.Grow(int(stream.ContentLength))
+		}
+		_, err = buf.ReadFrom(stream.Body)
+		pdf = buf.Bytes()
+	}
+	if err != nil {
+		return nil, &NetworkError{Message: "failed to read response body", Cause: err}
+	}
+
+	contentLength := stream.ContentLength
+	if contentLength <= 0 {
+		contentLength = int64(len(pdf))
 	}
 
 	return &GenerateResponse{
 		PDF:              pdf,
-		Filename:         filename,
-		GenerationTimeMs: generationTimeMs,
+		Filename:         stream.Filename,
+		GenerationTimeMs: stream.GenerationTimeMs,
+		QueueWaitMs:      stream.QueueWaitMs,
+		Region:           stream.Region,
 		ContentLength:    contentLength,
+		Variant:          stream.Variant,
+		DocumentID:       stream.DocumentID,
+		Duplicate:        stream.Duplicate,
+		Warnings:         stream.Warnings,
+		Trace:            stream.Trace,
 	}, nil
 }
 
-// parseErrorResponse parses an error response from the API.
+// getPDF downloads the document at path.
+func (c *Client) getPDF(ctx context.Context, path string) (*GenerateResponse, error) {
+	ctx = withOperation(ctx, operationDownload)
+
+	req, err := c.newRequest(ctx, "GET", path, nil, "")
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := c.send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	stream, err := c.streamResponse(resp)
+	if err != nil {
+		return nil, err
+	}
+
+	return readStream(stream)
+}
+
+var filenamePattern = regexp.MustCompile(`filename="?([^";\n]+)"?`)
+
+// maxErrorBodySize caps how much of an error response body is read.
+const maxErrorBodySize = 64 << 10
+
+// maxRawBodySnippet caps the length of APIError.RawBody.
+const maxRawBodySnippet = 512
+
+// htmlTitlePattern extracts the title of an HTML error page.
+var htmlTitlePattern = regexp.MustCompile(`(?is)<title[^>]*>(.*?)</title>`)
+
+// parseErrorResponse parses an error response from the API. Bodies that are
+// empty or not JSON, such as HTML pages from load balancers, produce an
+// "Unknown Error" with the HTTP status and a snippet of the body.
 func (c *Client) parseErrorResponse(resp *http.Response) error {
 	var errorBody APIErrorResponse
+	var rawBody string
 
-	body, _ := io.ReadAll(resp.Body)
-	if err := json.Unmarshal(body, &errorBody); err != nil {
+	body, _ := io.ReadAll(io.LimitReader(resp.Body, maxErrorBodySize))
+	if err := json.Unmarshal(body, &errorBody); err != nil || (errorBody.Error == "" && errorBody.Message == "") {
 		errorBody = APIErrorResponse{
 			Error:   "Unknown Error",
 			Message: resp.Status,
 		}
+		if match := htmlTitlePattern.FindSubmatch(body); match != nil {
+			if title := strings.Join(strings.Fields(string(match[1])), " "); title != "" && title != resp.Status {
+				errorBody.Message = fmt.Sprintf("%s (%s)", resp.Status, title)
+			}
+		}
+		rawBody = bodySnippet(body)
 	}
 
 	apiErr := &APIError{
@@ -184,6 +605,13 @@ func (c *Client) parseErrorResponse(resp *http.Response) error {
 		ErrorCode:  errorBody.Error,
 		Message:    errorBody.Message,
 		Details:    errorBody.Details,
+		RawBody:    rawBody,
+		RequestID:  resp.Header.Get(requestIDHeader),
+		DocsURL:    errorBody.DocsURL,
+	}
+	if resp.Request != nil {
+		apiErr.Method = resp.Request.Method
+		apiErr.Endpoint = resp.Request.URL.Path
 	}
 
 	if resp.StatusCode == 429 {
@@ -194,5 +622,35 @@ func (c *Client) parseErrorResponse(resp *http.Response) error {
 		}
 	}
 
+	if paths := apiErr.MissingVariables(); len(paths) > 0 {
+		return &MissingVariableError{APIError: apiErr, Paths: paths}
+	}
+
+	if templateErrors := apiErr.TemplateErrors(); len(templateErrors) > 0 {
+		first := templateErrors[0]
+		return &RenderError{
+			APIError: apiErr,
+			Line:     first.Line,
+			Column:   first.Column,
+			Path:     first.Path,
+			Snippet:  first.Snippet,
+		}
+	}
+
 	return apiErr
 }
+
+// bodySnippet returns the start of body with whitespace collapsed, truncated
+// to maxRawBodySnippet bytes on a UTF-8 boundary.
+func bodySnippet(body []byte) string {
+	snippet := strings.Join(strings.Fields(string(body)), " ")
+	if len(snippet) <= maxRawBodySnippet {
+		return snippet
+	}
+
+	cut := maxRawBodySnippet
+	for cut > 0 && !utf8.RuneStart(snippet[cut]) {
+		cut--
+	}
+	return snippet[:cut] + "..."
+}
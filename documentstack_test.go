@@ -0,0 +1,174 @@
+package documentstack
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+// testPDF is a minimal body accepted as a PDF by the client.
+var testPDF = []byte("%PDF-1.7\n%%EOF\n")
+
+// writePDF answers a request with testPDF.
+func writePDF(w http.ResponseWriter) {
+	w.Header().Set("Content-Type", "application/pdf")
+	w.Write(testPDF)
+}
+
+// newTestClient returns a client for a test server running handler.
+func newTestClient(t testing.TB, handler http.HandlerFunc) *Client {
+	t.Helper()
+
+	server := httptest.NewServer(handler)
+	t.Cleanup(server.Close)
+
+	client, err := New(Config{APIKey: "test-key", BaseURL: server.URL})
+	if err != nil {
+		t.Fatalf("New: %v", err)
+	}
+	return client
+}
+
+// roundTripperFunc adapts a function to http.RoundTripper.
+type roundTripperFunc func(*http.Request) (*http.Response, error)
+
+func (f roundTripperFunc) RoundTrip(req *http.Request) (*http.Response, error) {
+	return f(req)
+}
+
+func TestCloneConcurrentWithGenerate(t *testing.T) {
+	var mu sync.Mutex
+	tenants := make(map[string]string) // API key -> tenant ID
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		key := strings.TrimPrefix(r.Header.Get("Authorization"), "Bearer ")
+		tenant := r.Header.Get(tenantHeader)
+
+		mu.Lock()
+		defer mu.Unlock()
+		if previous, ok := tenants[key]; ok && previous != tenant {
+			t.Errorf("key %s sent tenant %q, previously %q", key, tenant, previous)
+		}
+		tenants[key] = tenant
+		writePDF(w)
+	})
+
+	ctx := context.Background()
+	request := &GenerateRequest{Data: map[string]interface{}{"n": 1}}
+
+	var wg sync.WaitGroup
+	for i := 0; i < 20; i++ {
+		i := i
+		wg.Add(3)
+		go func() {
+			defer wg.Done()
+			if _, err := client.Generate(ctx, "invoice", request); err != nil {
+				t.Errorf("Generate: %v", err)
+			}
+		}()
+		go func() {
+			defer wg.Done()
+			tenant := client.WithTenant(fmt.Sprintf("tenant-%d", i), fmt.Sprintf("key-%d", i))
+			if _, err := tenant.Generate(ctx, "invoice", request); err != nil {
+				t.Errorf("tenant Generate: %v", err)
+			}
+		}()
+		go func() {
+			defer wg.Done()
+			clone, err := client.Clone(func(config *Config) {
+				config.APIKey = fmt.Sprintf("clone-key-%d", i)
+				config.Headers["X-Clone"] = "true"
+			})
+			if err != nil {
+				t.Errorf("Clone: %v", err)
+				return
+			}
+			if _, err := clone.Generate(ctx, "invoice", request); err != nil {
+				t.Errorf("clone Generate: %v", err)
+			}
+		}()
+	}
+	wg.Wait()
+
+	if tenants["test-key"] != "" {
+		t.Errorf("parent client sent tenant %q", tenants["test-key"])
+	}
+	if tenants["key-7"] != "tenant-7" {
+		t.Errorf("key-7 sent tenant %q, want tenant-7", tenants["key-7"])
+	}
+	if _, ok := client.config.Headers["X-Clone"]; ok {
+		t.Error("Clone override modified the parent's headers")
+	}
+}
+
+func TestCloneTransportOverride(t *testing.T) {
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		writePDF(w)
+	})
+
+	if clone := client.WithTenant("tenant", ""); clone.httpClient != client.httpClient {
+		t.Error("WithTenant did not share the HTTP client")
+	}
+
+	var used atomic.Bool
+	clone, err := client.Clone(func(config *Config) {
+		config.Transport = roundTripperFunc(func(req *http.Request) (*http.Response, error) {
+			used.Store(true)
+			return http.DefaultTransport.RoundTrip(req)
+		})
+	})
+	if err != nil {
+		t.Fatalf("Clone: %v", err)
+	}
+	if _, err := clone.Generate(context.Background(), "invoice", nil); err != nil {
+		t.Fatalf("Generate: %v", err)
+	}
+	if !used.Load() {
+		t.Error("Clone ignored the Transport override")
+	}
+
+	timeouts, err := client.Clone(func(config *Config) {
+		config.Timeouts = &Timeouts{Connect: time.Second}
+	})
+	if err != nil {
+		t.Fatalf("Clone: %v", err)
+	}
+	if timeouts.httpClient == client.httpClient {
+		t.Error("Clone ignored the Timeouts.Connect override")
+	}
+}
+
+func TestGenerateRetryReusesIdempotencyKey(t *testing.T) {
+	var keys []string
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		keys = append(keys, r.Header.Get(idempotencyKeyHeader))
+		if len(keys)%2 == 1 {
+			w.WriteHeader(http.StatusBadGateway)
+			return
+		}
+		writePDF(w)
+	})
+	client.config.MaxRetries = 1
+
+	request := &GenerateRequest{Options: &GenerateOptions{Store: true, CallbackURL: "https://example.com/done"}}
+	for i := 0; i < 2; i++ {
+		if _, err := client.Generate(context.Background(), "invoice", request); err != nil {
+			t.Fatalf("Generate: %v", err)
+		}
+	}
+
+	if len(keys) != 4 {
+		t.Fatalf("got %d attempts, want 4", len(keys))
+	}
+	if keys[0] == "" || keys[0] != keys[1] {
+		t.Errorf("retry sent key %q, first attempt %q", keys[1], keys[0])
+	}
+	if keys[2] == keys[0] {
+		t.Error("separate calls sent the same idempotency key")
+	}
+}
@@ -0,0 +1,98 @@
+package documentstack
+
+import "fmt"
+
+// EmptyMode is how an empty value renders.
+type EmptyMode string
+
+// Empty value rendering modes.
+const (
+	// EmptyBlank renders nothing in place of the value. This is the default.
+	EmptyBlank EmptyMode = "blank"
+
+	// EmptyOmit omits the enclosing table row, list item or labelled field.
+	EmptyOmit EmptyMode = "omit"
+
+	// EmptyPlaceholder renders placeholder text, e.g. "n/a".
+	EmptyPlaceholder EmptyMode = "placeholder"
+
+	// EmptyLiteral renders the value as is, e.g. "0" for a zero number.
+	EmptyLiteral EmptyMode = "literal"
+)
+
+// EmptyRendering is how one kind of empty value renders.
+type EmptyRendering struct {
+	Mode EmptyMode `json:"mode"`
+
+	// Placeholder is the text rendered with EmptyPlaceholder.
+	Placeholder string `json:"placeholder,omitempty"`
+}
+
+// RenderBlank returns an EmptyRendering that renders nothing.
+func RenderBlank() *EmptyRendering {
+	return &EmptyRendering{Mode: EmptyBlank}
+}
+
+// RenderOmitted returns an EmptyRendering that omits the enclosing row or field.
+func RenderOmitted() *EmptyRendering {
+	return &EmptyRendering{Mode: EmptyOmit}
+}
+
+// RenderLiteral returns an EmptyRendering that renders the value as is.
+func RenderLiteral() *EmptyRendering {
+	return &EmptyRendering{Mode: EmptyLiteral}
+}
+
+// RenderPlaceholder returns an EmptyRendering that renders text.
+func RenderPlaceholder(text string) *EmptyRendering {
+	return &EmptyRendering{Mode: EmptyPlaceholder, Placeholder: text}
+}
+
+// EmptyValuePolicy controls how empty values render. Unset kinds fall back
+// to the request-wide policy, then to EmptyBlank.
+//
+// Example:
+//
+//	Options: &documentstack.GenerateOptions{
+//		EmptyValues: &documentstack.EmptyValuePolicy{
+//			Nil:         documentstack.RenderPlaceholder("n/a"),
+//			EmptyString: documentstack.RenderPlaceholder("n/a"),
+//		},
+//		FieldEmptyValues: map[string]*documentstack.EmptyValuePolicy{
+//			"items.discount": {Nil: documentstack.RenderOmitted(), Zero: documentstack.RenderOmitted()},
+//			"total":          {Zero: documentstack.RenderLiteral()},
+//		},
+//	}
+type EmptyValuePolicy struct {
+	// Nil is how null and absent values render.
+	Nil *EmptyRendering `json:"nil,omitempty"`
+
+	// EmptyString is how "" renders.
+	EmptyString *EmptyRendering `json:"emptyString,omitempty"`
+
+	// Zero is how the number 0 renders.
+	Zero *EmptyRendering `json:"zero,omitempty"`
+}
+
+func (p *EmptyValuePolicy) validate(path string) error {
+	if p == nil {
+		return nil
+	}
+
+	for _, rendering := range []*EmptyRendering{p.Nil, p.EmptyString, p.Zero} {
+		if rendering == nil {
+			continue
+		}
+		switch rendering.Mode {
+		case EmptyBlank, EmptyOmit, EmptyLiteral:
+		case EmptyPlaceholder:
+			if rendering.Placeholder == "" {
+				return NewValidationError(fmt.Sprintf("Placeholder text is required for empty values%s", path), nil)
+			}
+		default:
+			return NewValidationError(fmt.Sprintf("Invalid empty value mode %q%s", rendering.Mode, path), nil)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,220 @@
+package documentstack
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"time"
+)
+
+// EncryptionKeyType is where a customer-managed encryption key lives.
+type EncryptionKeyType string
+
+// Encryption key types.
+const (
+	KeyAWSKMS        EncryptionKeyType = "aws_kms"
+	KeyGCPKMS        EncryptionKeyType = "gcp_kms"
+	KeyAzureKeyVault EncryptionKeyType = "azure_key_vault"
+
+	// KeyUploaded is a 256-bit key uploaded to DocumentStack.
+	KeyUploaded EncryptionKeyType = "uploaded"
+)
+
+// EncryptionKeyStatus is the state of a customer-managed encryption key.
+type EncryptionKeyStatus string
+
+// Encryption key statuses.
+const (
+	// KeyPending keys are being validated, e.g. that DocumentStack may use the KMS key.
+	KeyPending EncryptionKeyStatus = "pending"
+	KeyActive  EncryptionKeyStatus = "active"
+
+	// KeyRotating keys are re-encrypting stored documents with a new key version.
+	KeyRotating EncryptionKeyStatus = "rotating"
+	KeyDisabled EncryptionKeyStatus = "disabled"
+
+	// KeyFailed keys could not be used; see EncryptionKey.StatusMessage.
+	KeyFailed EncryptionKeyStatus = "failed"
+)
+
+// EncryptionKeysService manages customer-managed keys (BYOK) that stored
+// documents are encrypted with at rest.
+type EncryptionKeysService struct {
+	client *Client
+}
+
+// EncryptionKey is a customer-managed encryption key.
+type EncryptionKey struct {
+	// ID is the unique key identifier. Set by the API.
+	ID string `json:"id,omitempty"`
+
+	// Name is the human-readable key name.
+	Name string `json:"name"`
+
+	Type EncryptionKeyType `json:"type"`
+
+	// KMSKeyURI identifies a KMS key: an AWS KMS key ARN, a GCP KMS
+	// CryptoKey resource name or an Azure Key Vault key identifier.
+	KMSKeyURI string `json:"kmsKeyUri,omitempty"`
+
+	// Material is the key of a KeyUploaded key. It is only sent on
+	// registration and never returned.
+	Material []byte `json:"material,omitempty"`
+
+	// Status is the key state. Set by the API.
+	Status EncryptionKeyStatus `json:"status,omitempty"`
+
+	// StatusMessage explains a KeyFailed status. Set by the API.
+	StatusMessage string `json:"statusMessage,omitempty"`
+
+	// Version is incremented on each rotation. Set by the API.
+	Version int `json:"version,omitempty"`
+
+	// RotatedAt is the time of the last rotation. Set by the API.
+	RotatedAt *time.Time `json:"rotatedAt,omitempty"`
+
+	// CreatedAt is the time the key was registered. Set by the API.
+	CreatedAt time.Time `json:"createdAt"`
+}
+
+// EncryptionKeyList is a page of encryption keys.
+type EncryptionKeyList struct {
+	Keys       []*EncryptionKey `json:"keys"`
+	NextCursor string           `json:"nextCursor,omitempty"`
+}
+
+// KeyBinding selects the key documents are encrypted with. A template binding
+// takes precedence over the workspace binding.
+type KeyBinding struct {
+	KeyID string `json:"keyId"`
+
+	// TemplateID binds the key to documents of one template. Empty binds it
+	// to the whole workspace.
+	TemplateID string `json:"templateId,omitempty"`
+}
+
+// List returns a page of encryption keys.
+func (s *EncryptionKeysService) List(ctx context.Context, opts *ListOptions) (*EncryptionKeyList, error) {
+	var result EncryptionKeyList
+	if err := s.client.doJSON(ctx, "GET", listPath("/api/v1/encryption-keys", opts), nil, &result); err != nil {
+		return nil, err
+	}
+
+	return &result, nil
+}
+
+// ListAll returns all encryption keys, fetching every page. See ListAllOptions.
+func (s *EncryptionKeysService) ListAll(ctx context.Context, opts *ListAllOptions) ([]*EncryptionKey, error) {
+	return listAll(ctx, opts, func(page *ListOptions) ([]*EncryptionKey, string, error) {
+		result, err := s.List(ctx, page)
+		if err != nil {
+			return nil, "", err
+		}
+		return result.Keys, result.NextCursor, nil
+	})
+}
+
+// Get retrieves an encryption key, including its status.
+func (s *EncryptionKeysService) Get(ctx context.Context, keyID string) (*EncryptionKey, error) {
+	if keyID == "" {
+		return nil, NewValidationError("Encryption key ID is required", nil)
+	}
+
+	var result EncryptionKey
+	endpoint := fmt.Sprintf("/api/v1/encryption-keys/%s", url.PathEscape(keyID))
+	if err := s.client.doJSON(ctx, "GET", endpoint, nil, &result); err != nil {
+		return nil, err
+	}
+
+	return &result, nil
+}
+
+// Register registers a customer-managed key. KMS keys start in KeyPending
+// until DocumentStack has verified it can use them.
+//
+// Example:
+//
+//	key, err := client.EncryptionKeys.Register(ctx, &documentstack.EncryptionKey{
+//		Name:      "documents-eu",
+//		Type:      documentstack.KeyAWSKMS,
+//		KMSKeyURI: "arn:aws:kms:eu-central-1:111122223333:key/1234abcd-12ab-34cd-56ef-1234567890ab",
+//	})
+func (s *EncryptionKeysService) Register(ctx context.Context, key *EncryptionKey) (*EncryptionKey, error) {
+	if key == nil || key.Name == "" {
+		return nil, NewValidationError("Encryption key name is required", nil)
+	}
+
+	switch key.Type {
+	case KeyAWSKMS, KeyGCPKMS, KeyAzureKeyVault:
+		if key.KMSKeyURI == "" {
+			return nil, NewValidationError("KMS key URI is required", nil)
+		}
+	case KeyUploaded:
+		if len(key.Material) != 32 {
+			return nil, NewValidationError("Uploaded key material must be 32 bytes", nil)
+		}
+	default:
+		return nil, NewValidationError(fmt.Sprintf("Invalid encryption key type %q", key.Type), nil)
+	}
+
+	var result EncryptionKey
+	if err := s.client.doJSON(ctx, "POST", "/api/v1/encryption-keys", key, &result); err != nil {
+		return nil, err
+	}
+
+	return &result, nil
+}
+
+// Rotate starts a rotation to a new key version. Stored documents are
+// re-encrypted in the background while the key is KeyRotating.
+func (s *EncryptionKeysService) Rotate(ctx context.Context, keyID string) (*EncryptionKey, error) {
+	return s.action(ctx, keyID, "rotate")
+}
+
+// Disable disables a key. Documents encrypted with it cannot be read until it
+// is enabled again.
+func (s *EncryptionKeysService) Disable(ctx context.Context, keyID string) (*EncryptionKey, error) {
+	return s.action(ctx, keyID, "disable")
+}
+
+// Enable re-enables a disabled key.
+func (s *EncryptionKeysService) Enable(ctx context.Context, keyID string) (*EncryptionKey, error) {
+	return s.action(ctx, keyID, "enable")
+}
+
+func (s *EncryptionKeysService) action(ctx context.Context, keyID, action string) (*EncryptionKey, error) {
+	if keyID == "" {
+		return nil, NewValidationError("Encryption key ID is required", nil)
+	}
+
+	var result EncryptionKey
+	endpoint := fmt.Sprintf("/api/v1/encryption-keys/%s/%s", url.PathEscape(keyID), action)
+	if err := s.client.doJSON(ctx, "POST", endpoint, nil, &result); err != nil {
+		return nil, err
+	}
+
+	return &result, nil
+}
+
+// Bind sets the key new documents of the workspace or a template are
+// encrypted with.
+func (s *EncryptionKeysService) Bind(ctx context.Context, binding *KeyBinding) error {
+	if binding == nil || binding.KeyID == "" {
+		return NewValidationError("Encryption key ID is required", nil)
+	}
+
+	return s.client.doJSON(ctx, "PUT", bindingPath(binding.TemplateID), binding, nil)
+}
+
+// Unbind removes the key binding of the workspace, or of a template if
+// templateID is non-empty. Unbound documents use DocumentStack-managed keys.
+func (s *EncryptionKeysService) Unbind(ctx context.Context, templateID string) error {
+	return s.client.doJSON(ctx, "DELETE", bindingPath(templateID), nil, nil)
+}
+
+func bindingPath(templateID string) string {
+	if templateID == "" {
+		return "/api/v1/encryption-keys/bindings/workspace"
+	}
+	return fmt.Sprintf("/api/v1/encryption-keys/bindings/templates/%s", url.PathEscape(templateID))
+}
@@ -1,7 +1,9 @@
 package documentstack
 
 import (
+	"encoding/json"
 	"fmt"
+	"strings"
 )
 
 // DocumentStackError is the base error type for all SDK errors.
@@ -19,12 +21,84 @@ type APIError struct {
 	ErrorCode  string
 	Message    string
 	Details    interface{}
+
+	// RawBody is the start of the response body when it was not a JSON error,
+	// e.g. an HTML page from a proxy, for debugging.
+	RawBody string
+
+	// RequestID is the X-Request-ID of the failed request, for support requests.
+	RequestID string
+
+	// Method and Endpoint identify the failed request, e.g. "POST" and
+	// "/api/v1/generate/tmpl_123".
+	Method   string
+	Endpoint string
+
+	// DocsURL links to documentation for the error, if the API provides one.
+	DocsURL string
+
+	// Trace is the timing of the failed attempt, if Config.Trace is set.
+	Trace *Trace
 }
 
 func (e *APIError) Error() string {
 	return fmt.Sprintf("%s: %s", e.ErrorCode, e.Message)
 }
 
+// Format returns the error message. If verbose is true, it returns a
+// multi-line diagnostic with the request, request ID, documentation link and
+// a troubleshooting hint, e.g. for CLI output or logs.
+func (e *APIError) Format(verbose bool) string {
+	if !verbose {
+		return e.Error()
+	}
+
+	var b strings.Builder
+	fmt.Fprintf(&b, "%s (HTTP %d)\n", e.Error(), e.StatusCode)
+	if e.Endpoint != "" {
+		fmt.Fprintf(&b, "  Request:    %s %s\n", e.Method, e.Endpoint)
+	}
+	if e.RequestID != "" {
+		fmt.Fprintf(&b, "  Request ID: %s\n", e.RequestID)
+	}
+	for _, violation := range e.FieldViolations() {
+		fmt.Fprintf(&b, "  Field:      %s: %s\n", violation.Path, violation.Message)
+	}
+	for _, path := range e.MissingVariables() {
+		fmt.Fprintf(&b, "  Missing:    %s\n", path)
+	}
+	if e.RawBody != "" {
+		fmt.Fprintf(&b, "  Body:       %s\n", e.RawBody)
+	}
+	if e.DocsURL != "" {
+		fmt.Fprintf(&b, "  Docs:       %s\n", e.DocsURL)
+	}
+	if hint := e.hint(); hint != "" {
+		fmt.Fprintf(&b, "  Hint:       %s\n", hint)
+	}
+
+	return strings.TrimSuffix(b.String(), "\n")
+}
+
+// hint returns a troubleshooting hint for the status code.
+func (e *APIError) hint() string {
+	switch {
+	case e.IsValidationError():
+		return "check the request data against the template schema (TemplatesService.Schema)"
+	case e.IsAuthenticationError():
+		return "check that Config.APIKey is set and has not been revoked"
+	case e.IsForbiddenError():
+		return "check that the API key has the scopes required for this operation"
+	case e.IsNotFoundError():
+		return "check that the template or resource ID exists in this account"
+	case e.IsRateLimitError():
+		return "reduce the request rate or set Config.MaxRetries to retry after Retry-After"
+	case e.IsServerError():
+		return "retry later; include the request ID when contacting support"
+	}
+	return ""
+}
+
 // IsValidationError returns true if the error is a validation error (400).
 func (e *APIError) IsValidationError() bool {
 	return e.StatusCode == 400
@@ -55,15 +129,188 @@ func (e *APIError) IsServerError() bool {
 	return e.StatusCode >= 500
 }
 
+// ErrorDetails is the typed form of APIError.Details.
+type ErrorDetails struct {
+	// FieldViolations lists request fields that failed validation.
+	FieldViolations []FieldViolation `json:"fieldViolations,omitempty"`
+
+	// QuotaViolations lists quotas that the request exceeded.
+	QuotaViolations []QuotaViolation `json:"quotaViolations,omitempty"`
+
+	// TemplateErrors lists errors in the template source.
+	TemplateErrors []TemplateError `json:"templateErrors,omitempty"`
+
+	// MissingVariables lists the paths of template variables absent from the
+	// data of a strict generation. See GenerateOptions.Strict.
+	MissingVariables []string `json:"missingVariables,omitempty"`
+}
+
+// FieldViolation describes a request field that failed validation.
+type FieldViolation struct {
+	// Path is the path of the offending field, e.g. "data.customer.email".
+	Path string `json:"path"`
+
+	// Code is a machine-readable violation code, e.g. "required" or "invalid_format".
+	Code string `json:"code"`
+
+	// Message is a human-readable description of the violation.
+	Message string `json:"message"`
+}
+
+// QuotaViolation describes a quota that the request exceeded.
+type QuotaViolation struct {
+	// Quota is the name of the quota, e.g. "monthly_generations".
+	Quota string `json:"quota"`
+
+	// Limit is the quota limit.
+	Limit int64 `json:"limit"`
+
+	// Used is the current usage counted against the quota.
+	Used int64 `json:"used"`
+
+	// Message is a human-readable description of the violation.
+	Message string `json:"message"`
+}
+
+// TemplateError describes an error at a position in the template source.
+type TemplateError struct {
+	Line    int    `json:"line"`
+	Column  int    `json:"column"`
+	Message string `json:"message"`
+
+	// Path is the data variable path involved, e.g. "customer.address.street", if any.
+	Path string `json:"path,omitempty"`
+
+	// Snippet is the offending template expression, if available.
+	Snippet string `json:"snippet,omitempty"`
+}
+
+// StructuredDetails decodes Details into typed detail structs. Parts of
+// Details that do not match a known detail type are ignored; the result is
+// never nil.
+func (e *APIError) StructuredDetails() *ErrorDetails {
+	details := &ErrorDetails{}
+	if e.Details == nil {
+		return details
+	}
+
+	if typed, ok := e.Details.(*ErrorDetails); ok {
+		return typed
+	}
+
+	data, err := json.Marshal(e.Details)
+	if err != nil {
+		return details
+	}
+	_ = json.Unmarshal(data, details)
+
+	return details
+}
+
+// FieldViolations returns the field violations in Details, if any.
+func (e *APIError) FieldViolations() []FieldViolation {
+	return e.StructuredDetails().FieldViolations
+}
+
+// QuotaViolations returns the quota violations in Details, if any.
+func (e *APIError) QuotaViolations() []QuotaViolation {
+	return e.StructuredDetails().QuotaViolations
+}
+
+// TemplateErrors returns the template errors in Details, if any.
+func (e *APIError) TemplateErrors() []TemplateError {
+	return e.StructuredDetails().TemplateErrors
+}
+
+// MissingVariables returns the missing variable paths in Details, if any.
+func (e *APIError) MissingVariables() []string {
+	return e.StructuredDetails().MissingVariables
+}
+
 // RateLimitError extends APIError with retry information.
 type RateLimitError struct {
 	*APIError
 	RetryAfter int // Seconds to wait before retrying
 }
 
+func (e *RateLimitError) Unwrap() error {
+	return e.APIError
+}
+
+// RenderError is returned when generation fails because the template could
+// not be rendered. It describes the first template error; all errors are
+// available from TemplateErrors.
+type RenderError struct {
+	*APIError
+	Line    int    // Line in the template source, 1-based
+	Column  int    // Column in the template source, 1-based
+	Path    string // Data variable path involved, if any
+	Snippet string // Offending template expression, if available
+}
+
+func (e *RenderError) Error() string {
+	msg := fmt.Sprintf("%s at line %d, column %d", e.APIError.Error(), e.Line, e.Column)
+	if e.Path != "" {
+		msg += fmt.Sprintf(" (variable %s)", e.Path)
+	}
+	if e.Snippet != "" {
+		msg += fmt.Sprintf(": %s", e.Snippet)
+	}
+	return msg
+}
+
+func (e *RenderError) Unwrap() error {
+	return e.APIError
+}
+
+// MissingVariableError is returned when a generation with
+// GenerateOptions.Strict fails because the data lacks variables the template
+// references.
+type MissingVariableError struct {
+	*APIError
+
+	// Paths are the missing variable paths, e.g. "customer.address.street".
+	Paths []string
+}
+
+func (e *MissingVariableError) Error() string {
+	return fmt.Sprintf("%s: missing variables %s", e.APIError.Error(), strings.Join(e.Paths, ", "))
+}
+
+func (e *MissingVariableError) Unwrap() error {
+	return e.APIError
+}
+
+// FeatureNotEnabledError is returned without calling the API when a method
+// needs a feature that is not enabled for the account. See Client.Capabilities.
+type FeatureNotEnabledError struct {
+	Feature Feature
+}
+
+func (e *FeatureNotEnabledError) Error() string {
+	return fmt.Sprintf("feature %q is not enabled for this account", e.Feature)
+}
+
+// UnexpectedContentError is returned when a successful response that should
+// contain a PDF does not, e.g. an HTML page served with HTTP 200 by a proxy.
+type UnexpectedContentError struct {
+	StatusCode  int
+	ContentType string
+
+	// Snippet is the start of the response body.
+	Snippet string
+}
+
+func (e *UnexpectedContentError) Error() string {
+	return fmt.Sprintf("expected a PDF but received %q content (HTTP %d): %s", e.ContentType, e.StatusCode, e.Snippet)
+}
+
 // TimeoutError is returned when a request times out.
 type TimeoutError struct {
 	Timeout int // Timeout in seconds
+
+	// Trace is the timing of the failed attempt, if Config.Trace is set.
+	Trace *Trace
 }
 
 func (e *TimeoutError) Error() string {
@@ -74,6 +321,9 @@ func (e *TimeoutError) Error() string {
 type NetworkError struct {
 	Message string
 	Cause   error
+
+	// Trace is the timing of the failed attempt, if Config.Trace is set.
+	Trace *Trace
 }
 
 func (e *NetworkError) Error() string {
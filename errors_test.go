@@ -0,0 +1,97 @@
+package documentstack
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+	"unicode/utf8"
+)
+
+// errorBodySeeds are response bodies seen from the API and from
+// intermediaries in front of it.
+var errorBodySeeds = []string{
+	`{"error":"Not Found","message":"Template not found"}`,
+	`{"error":"Bad Request","message":"Render failed","details":{"templateErrors":[{"line":3,"column":7,"path":"items[0]","snippet":"{{ items[0].price }}"}]}}`,
+	`{"error":"Bad Request","message":"Missing","details":{"missingVariables":["customer.name"]}}`,
+	`{"error":"Bad Request","message":"Invalid","details":{"fieldViolations":[{"field":"data","description":"required"}]}}`,
+	`{"error":"Bad Request","message":"trunc`,
+	`{"error":`,
+	`[1,2,3]`,
+	`null`,
+	"<html><head><title>502 Bad Gateway</title></head><body><h1>Bad Gateway</h1></body></html>",
+	"<html><title>\n  Captive   Portal \n</title>",
+	"<title></title>",
+	strings.Repeat("x", 10*maxRawBodySnippet),
+	`{"error":"Bad Request","message":"` + strings.Repeat("a", maxErrorBodySize) + `"}`,
+	"<html><title>Oversized</title>" + strings.Repeat("<p>filler</p>", maxErrorBodySize/8),
+	strings.Repeat("é", maxRawBodySnippet),
+	strings.Repeat(" \n\t", 1000),
+	"\xff\xfe\xfd",
+	"",
+}
+
+func FuzzParseErrorResponse(f *testing.F) {
+	for _, seed := range errorBodySeeds {
+		for _, status := range []int{400, 401, 404, 422, 429, 500, 502} {
+			f.Add(status, []byte(seed))
+		}
+	}
+
+	client := &Client{}
+	f.Fuzz(func(t *testing.T, status int, body []byte) {
+		if status < 100 || status > 999 {
+			return
+		}
+
+		request, _ := http.NewRequest("POST", "https://api.documentstack.dev/api/v1/generate", nil)
+		resp := &http.Response{
+			StatusCode: status,
+			Status:     fmt.Sprintf("%d %s", status, http.StatusText(status)),
+			Header:     http.Header{"Retry-After": []string{"5"}},
+			Body:       io.NopCloser(bytes.NewReader(body)),
+			Request:    request,
+		}
+
+		err := client.parseErrorResponse(resp)
+		if err == nil {
+			t.Fatal("parseErrorResponse returned nil")
+		}
+		_ = err.Error()
+		_ = fmt.Sprintf("%+v", err)
+
+		var apiErr *APIError
+		if !errors.As(err, &apiErr) {
+			t.Fatalf("error %T is not an *APIError", err)
+		}
+		if apiErr.StatusCode != status {
+			t.Errorf("StatusCode = %d, want %d", apiErr.StatusCode, status)
+		}
+		if max := maxRawBodySnippet + len("..."); len(apiErr.RawBody) > max {
+			t.Errorf("len(RawBody) = %d, want at most %d", len(apiErr.RawBody), max)
+		}
+	})
+}
+
+func FuzzBodySnippet(f *testing.F) {
+	for _, seed := range errorBodySeeds {
+		f.Add([]byte(seed))
+	}
+
+	f.Fuzz(func(t *testing.T, body []byte) {
+		snippet := bodySnippet(body)
+
+		if max := maxRawBodySnippet + len("..."); len(snippet) > max {
+			t.Fatalf("len(snippet) = %d, want at most %d", len(snippet), max)
+		}
+		if utf8.Valid(body) && !utf8.ValidString(snippet) {
+			t.Fatalf("snippet of valid UTF-8 is invalid: %q", snippet)
+		}
+		if strings.ContainsAny(snippet, "\n\t") {
+			t.Fatalf("snippet contains unnormalized whitespace: %q", snippet)
+		}
+	})
+}
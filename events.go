@@ -0,0 +1,200 @@
+package documentstack
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+)
+
+// CurrentEventVersion is the newest webhook payload version this SDK knows.
+// Webhook.EventVersion pins the version an endpoint receives; UnmarshalEvent
+// upgrades older payloads to the current structs.
+const CurrentEventVersion = 2
+
+// Event is a webhook event.
+type Event struct {
+	// ID uniquely identifies the event. Redeliveries of an event keep its ID.
+	ID string `json:"id"`
+
+	// Type is the event type, e.g. EventGenerationCompleted.
+	Type string `json:"type"`
+
+	// Version is the payload version of Data.
+	Version int `json:"version"`
+
+	CreatedAt time.Time `json:"createdAt"`
+
+	// Data is the raw event payload.
+	Data json.RawMessage `json:"data"`
+
+	// Payload is the decoded payload: a *GenerationCompletedEvent or
+	// *GenerationFailedEvent, or nil for event types unknown to this SDK.
+	Payload interface{} `json:"-"`
+}
+
+// GenerationCompletedEvent is the payload of EventGenerationCompleted.
+type GenerationCompletedEvent struct {
+	TemplateID string `json:"templateId"`
+
+	// JobID is the asynchronous job, if the generation was submitted with JobsService.Submit.
+	JobID string `json:"jobId,omitempty"`
+
+	// DocumentID is the stored document, if GenerateOptions.Store was set.
+	DocumentID string `json:"documentId,omitempty"`
+
+	Filename         string `json:"filename"`
+	Size             int64  `json:"size"`
+	PageCount        int    `json:"pageCount"`
+	GenerationTimeMs int64  `json:"generationTimeMs"`
+
+	// Tags and OnBehalfOf are those of the generation.
+	Tags       map[string]string `json:"tags,omitempty"`
+	OnBehalfOf string            `json:"onBehalfOf,omitempty"`
+
+	// Warnings are problems that did not prevent generation. Since version 2.
+	Warnings []Warning `json:"warnings,omitempty"`
+}
+
+// GenerationFailedEvent is the payload of EventGenerationFailed.
+type GenerationFailedEvent struct {
+	TemplateID string `json:"templateId"`
+	JobID      string `json:"jobId,omitempty"`
+
+	// Error describes the failure.
+	Error *APIErrorResponse `json:"error"`
+
+	Tags       map[string]string `json:"tags,omitempty"`
+	OnBehalfOf string            `json:"onBehalfOf,omitempty"`
+}
+
+// GenerationCompleted returns the payload of a generation.completed event.
+func (e *Event) GenerationCompleted() (*GenerationCompletedEvent, bool) {
+	payload, ok := e.Payload.(*GenerationCompletedEvent)
+	return payload, ok
+}
+
+// GenerationFailed returns the payload of a generation.failed event.
+func (e *Event) GenerationFailed() (*GenerationFailedEvent, bool) {
+	payload, ok := e.Payload.(*GenerationFailedEvent)
+	return payload, ok
+}
+
+// eventDecoders decode the payload of each known event type, upgrading older
+// payload versions to the current structs.
+var eventDecoders = map[string]func(version int, data json.RawMessage) (interface{}, error){
+	EventGenerationCompleted: decodeGenerationCompleted,
+	EventGenerationFailed:    decodeGenerationFailed,
+}
+
+// UnmarshalEvent decodes a webhook request body into an Event with a typed
+// Payload. Payloads of older versions are upgraded to the current structs;
+// unknown fields, including those of versions newer than
+// CurrentEventVersion, are ignored. Events of unknown types are returned with
+// a nil Payload and their raw Data. UnmarshalEvent does not verify the
+// request signature; see VerifyWebhookSignature and WebhookHandler.
+//
+// Example:
+//
+//	event, err := documentstack.UnmarshalEvent(body)
+//	if err != nil {
+//		return err
+//	}
+//	if completed, ok := event.GenerationCompleted(); ok {
+//		archive(completed.DocumentID)
+//	}
+func UnmarshalEvent(data []byte) (*Event, error) {
+	var event Event
+	if err := json.Unmarshal(data, &event); err != nil {
+		return nil, &DocumentStackError{Message: fmt.Sprintf("failed to decode webhook event: %v", err)}
+	}
+	if event.ID == "" || event.Type == "" {
+		return nil, &DocumentStackError{Message: "failed to decode webhook event: missing id or type"}
+	}
+	if event.Version == 0 {
+		event.Version = 1
+	}
+
+	decode, ok := eventDecoders[event.Type]
+	if !ok || len(event.Data) == 0 {
+		return &event, nil
+	}
+
+	payload, err := decode(event.Version, event.Data)
+	if err != nil {
+		return nil, &DocumentStackError{Message: fmt.Sprintf("failed to decode %s event %s: %v", event.Type, event.ID, err)}
+	}
+	event.Payload = payload
+
+	return &event, nil
+}
+
+// generationCompletedV1 is the version 1 payload of EventGenerationCompleted.
+type generationCompletedV1 struct {
+	TemplateID string            `json:"template_id"`
+	JobID      string            `json:"job_id"`
+	DocumentID string            `json:"document_id"`
+	Filename   string            `json:"filename"`
+	Size       int64             `json:"size"`
+	Pages      int               `json:"pages"`
+	DurationMs int64             `json:"duration_ms"`
+	Tags       map[string]string `json:"tags"`
+	OnBehalfOf string            `json:"on_behalf_of"`
+}
+
+func decodeGenerationCompleted(version int, data json.RawMessage) (interface{}, error) {
+	if version == 1 {
+		var v1 generationCompletedV1
+		if err := json.Unmarshal(data, &v1); err != nil {
+			return nil, err
+		}
+		return &GenerationCompletedEvent{
+			TemplateID:       v1.TemplateID,
+			JobID:            v1.JobID,
+			DocumentID:       v1.DocumentID,
+			Filename:         v1.Filename,
+			Size:             v1.Size,
+			PageCount:        v1.Pages,
+			GenerationTimeMs: v1.DurationMs,
+			Tags:             v1.Tags,
+			OnBehalfOf:       v1.OnBehalfOf,
+		}, nil
+	}
+
+	var payload GenerationCompletedEvent
+	if err := json.Unmarshal(data, &payload); err != nil {
+		return nil, err
+	}
+	return &payload, nil
+}
+
+// generationFailedV1 is the version 1 payload of EventGenerationFailed.
+type generationFailedV1 struct {
+	TemplateID string            `json:"template_id"`
+	JobID      string            `json:"job_id"`
+	Error      string            `json:"error"`
+	Message    string            `json:"message"`
+	Tags       map[string]string `json:"tags"`
+	OnBehalfOf string            `json:"on_behalf_of"`
+}
+
+func decodeGenerationFailed(version int, data json.RawMessage) (interface{}, error) {
+	if version == 1 {
+		var v1 generationFailedV1
+		if err := json.Unmarshal(data, &v1); err != nil {
+			return nil, err
+		}
+		return &GenerationFailedEvent{
+			TemplateID: v1.TemplateID,
+			JobID:      v1.JobID,
+			Error:      &APIErrorResponse{Error: v1.Error, Message: v1.Message},
+			Tags:       v1.Tags,
+			OnBehalfOf: v1.OnBehalfOf,
+		}, nil
+	}
+
+	var payload GenerationFailedEvent
+	if err := json.Unmarshal(data, &payload); err != nil {
+		return nil, err
+	}
+	return &payload, nil
+}
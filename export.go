@@ -0,0 +1,158 @@
+package documentstack
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/url"
+	"time"
+)
+
+// ArchiveFormat is the file format of an account archive.
+type ArchiveFormat string
+
+// Archive formats.
+const (
+	ArchiveZIP   ArchiveFormat = "zip"
+	ArchiveTarGz ArchiveFormat = "tar.gz"
+)
+
+// ArchiveStatus is the state of an account archive.
+type ArchiveStatus string
+
+// Archive statuses.
+const (
+	ArchivePending   ArchiveStatus = "pending"
+	ArchiveRunning   ArchiveStatus = "running"
+	ArchiveCompleted ArchiveStatus = "completed"
+	ArchiveFailed    ArchiveStatus = "failed"
+	ArchiveExpired   ArchiveStatus = "expired"
+)
+
+// ExportService exports stored documents in bulk, e.g. for backups or when
+// offboarding a customer.
+type ExportService struct {
+	client *Client
+}
+
+// ArchiveFilter selects the stored documents included in an archive. All set
+// filters must match.
+type ArchiveFilter struct {
+	// From and To limit the archive to documents created in [From, To). Zero
+	// values are unbounded.
+	From time.Time `json:"-"`
+	To   time.Time `json:"-"`
+
+	// TemplateID limits the archive to documents generated from this template.
+	TemplateID string `json:"templateId,omitempty"`
+
+	// Tags limits the archive to documents with all of these tags.
+	Tags map[string]string `json:"tags,omitempty"`
+
+	// OnBehalfOf limits the archive to documents generated for this customer.
+	// See WithOnBehalfOf.
+	OnBehalfOf string `json:"onBehalfOf,omitempty"`
+
+	// Format is the archive file format.
+	// Default: ArchiveZIP
+	Format ArchiveFormat `json:"format,omitempty"`
+
+	// IncludeMetadata adds a metadata.jsonl file with one Document per line.
+	IncludeMetadata bool `json:"includeMetadata,omitempty"`
+}
+
+// Archive is a bulk export of stored documents. It is built asynchronously;
+// poll it with Get until Done and fetch it with Download before it expires.
+type Archive struct {
+	ID     string        `json:"id"`
+	Status ArchiveStatus `json:"status"`
+	Format ArchiveFormat `json:"format"`
+
+	// DocumentCount is the number of documents in the archive.
+	DocumentCount int `json:"documentCount"`
+
+	// Size is the archive size in bytes, once completed.
+	Size int64 `json:"size,omitempty"`
+
+	// Error describes why the archive failed, if Status is ArchiveFailed.
+	Error string `json:"error,omitempty"`
+
+	// ExpiresAt is when the completed archive is deleted.
+	ExpiresAt *time.Time `json:"expiresAt,omitempty"`
+
+	CreatedAt   time.Time  `json:"createdAt"`
+	CompletedAt *time.Time `json:"completedAt,omitempty"`
+}
+
+// Done reports whether the archive has completed, failed or expired.
+func (a *Archive) Done() bool {
+	return a.Status == ArchiveCompleted || a.Status == ArchiveFailed || a.Status == ArchiveExpired
+}
+
+// CreateArchive starts building an archive of the stored documents matching
+// filter, which may be nil to export all documents.
+//
+// Example:
+//
+//	archive, err := client.Export.CreateArchive(ctx, &documentstack.ArchiveFilter{
+//		OnBehalfOf:      "cus_123",
+//		IncludeMetadata: true,
+//	})
+func (s *ExportService) CreateArchive(ctx context.Context, filter *ArchiveFilter) (*Archive, error) {
+	if filter == nil {
+		filter = &ArchiveFilter{}
+	}
+	if !filter.From.IsZero() && !filter.To.IsZero() && !filter.From.Before(filter.To) {
+		return nil, NewValidationError("From must be before To", nil)
+	}
+	switch filter.Format {
+	case "", ArchiveZIP, ArchiveTarGz:
+	default:
+		return nil, NewValidationError(fmt.Sprintf("Invalid archive format %q", filter.Format), nil)
+	}
+
+	body := struct {
+		*ArchiveFilter
+		From *time.Time `json:"from,omitempty"`
+		To   *time.Time `json:"to,omitempty"`
+	}{ArchiveFilter: filter}
+	if !filter.From.IsZero() {
+		body.From = &filter.From
+	}
+	if !filter.To.IsZero() {
+		body.To = &filter.To
+	}
+
+	var result Archive
+	if err := s.client.doJSON(ctx, "POST", "/api/v1/exports", body, &result); err != nil {
+		return nil, err
+	}
+
+	return &result, nil
+}
+
+// Get retrieves an archive, including its current status.
+func (s *ExportService) Get(ctx context.Context, archiveID string) (*Archive, error) {
+	if archiveID == "" {
+		return nil, NewValidationError("Archive ID is required", nil)
+	}
+
+	var result Archive
+	endpoint := fmt.Sprintf("/api/v1/exports/%s", url.PathEscape(archiveID))
+	if err := s.client.doJSON(ctx, "GET", endpoint, nil, &result); err != nil {
+		return nil, err
+	}
+
+	return &result, nil
+}
+
+// Download downloads a completed archive into w in parallel ranged segments
+// and returns its size. See DocumentsService.DownloadTo.
+func (s *ExportService) Download(ctx context.Context, archiveID string, w io.WriterAt, opts *SegmentedDownloadOptions) (int64, error) {
+	if archiveID == "" {
+		return 0, NewValidationError("Archive ID is required", nil)
+	}
+
+	endpoint := fmt.Sprintf("/api/v1/exports/%s/content", url.PathEscape(archiveID))
+	return s.client.downloadSegmented(ctx, endpoint, w, opts)
+}
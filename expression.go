@@ -0,0 +1,54 @@
+package documentstack
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/url"
+)
+
+// ExpressionResult is the result of evaluating a template expression.
+type ExpressionResult struct {
+	// Output is the rendered text of the expression, as it would appear in
+	// the document.
+	Output string `json:"output"`
+
+	// Value is the expression's value before formatting, as JSON.
+	Value json.RawMessage `json:"value,omitempty"`
+
+	// Type is the type of Value, e.g. "string", "number" or "date".
+	Type string `json:"type,omitempty"`
+}
+
+// EvaluateExpression evaluates a single template expression against data in
+// the context of a template, with its helpers, partials and default data, so
+// formatting logic can be debugged without rendering the whole document.
+// Syntax and evaluation errors are returned as *RenderError.
+//
+// Example:
+//
+//	result, err := client.EvaluateExpression(ctx, "invoice", `{{formatCurrency total "EUR"}}`, map[string]interface{}{
+//		"total": 1234.5,
+//	})
+//	fmt.Println(result.Output) // €1,234.50
+func (c *Client) EvaluateExpression(ctx context.Context, templateID, expression string, data map[string]interface{}) (*ExpressionResult, error) {
+	if templateID == "" {
+		return nil, NewValidationError("Template ID is required", nil)
+	}
+	if expression == "" {
+		return nil, NewValidationError("Expression is required", nil)
+	}
+
+	body := map[string]interface{}{
+		"expression": expression,
+		"data":       data,
+	}
+
+	var result ExpressionResult
+	endpoint := fmt.Sprintf("/api/v1/templates/%s/evaluate", url.PathEscape(templateID))
+	if err := c.queryJSON(ctx, endpoint, body, &result); err != nil {
+		return nil, err
+	}
+
+	return &result, nil
+}
@@ -0,0 +1,244 @@
+package documentstack
+
+import (
+	"context"
+	"encoding/json"
+	"reflect"
+	"strings"
+	"time"
+)
+
+// Extract pulls structured fields from a document into a value of type T.
+// The extraction schema is derived from T's exported fields and their json
+// tags; an optional `extract` tag describes a field to the extraction model.
+//
+// Example:
+//
+//	type Invoice struct {
+//		Number string    `json:"number" extract:"The invoice number"`
+//		Total  float64   `json:"total" extract:"Grand total including tax"`
+//		Date   time.Time `json:"date"`
+//	}
+//
+//	invoice, err := documentstack.Extract[Invoice](ctx, client, documentstack.SourceFromBytes(pdf))
+func Extract[T any](ctx context.Context, client *Client, source *Source) (*T, error) {
+	var value T
+	schema := jsonSchemaFor(reflect.TypeOf(value))
+
+	data, err := client.ExtractRaw(ctx, source, schema)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := json.Unmarshal(data, &value); err != nil {
+		return nil, &DocumentStackError{Message: "failed to decode extracted data: " + err.Error()}
+	}
+
+	return &value, nil
+}
+
+// ExtractRaw pulls the fields described by schema, a JSON Schema object, from
+// a document and returns them as JSON. Use Extract to decode into a Go type.
+func (c *Client) ExtractRaw(ctx context.Context, source *Source, schema map[string]interface{}) (json.RawMessage, error) {
+	if err := source.validate(); err != nil {
+		return nil, err
+	}
+	if schema == nil {
+		return nil, NewValidationError("Extraction schema is required", nil)
+	}
+	if err := c.requireFeature(ctx, FeatureOCR); err != nil {
+		return nil, err
+	}
+
+	body := map[string]interface{}{
+		"source": source,
+		"schema": schema,
+	}
+
+	var result struct {
+		Data json.RawMessage `json:"data"`
+	}
+	if err := c.queryJSON(ctx, "/api/v1/extract", body, &result); err != nil {
+		return nil, err
+	}
+
+	return result.Data, nil
+}
+
+var (
+	timeType       = reflect.TypeOf(time.Time{})
+	durationType   = reflect.TypeOf(time.Duration(0))
+	rawMessageType = reflect.TypeOf(json.RawMessage(nil))
+)
+
+// jsonSchemaFor derives a JSON Schema from a Go type, following encoding/json
+// naming rules. Descriptions are taken from `extract` struct tags. Recursive
+// struct types are referenced from "$defs".
+func jsonSchemaFor(t reflect.Type) map[string]interface{} {
+	builder := &schemaBuilder{
+		visiting:  map[reflect.Type]bool{},
+		recursive: map[reflect.Type]bool{},
+		defs:      map[string]interface{}{},
+	}
+
+	schema := builder.schema(t)
+	if len(builder.defs) > 0 {
+		schema["$defs"] = builder.defs
+	}
+	return schema
+}
+
+// schemaBuilder derives a JSON Schema, tracking the struct types being
+// derived to reference recursive types instead of expanding them forever.
+type schemaBuilder struct {
+	visiting  map[reflect.Type]bool
+	recursive map[reflect.Type]bool
+	defs      map[string]interface{}
+}
+
+func (b *schemaBuilder) schema(t reflect.Type) map[string]interface{} {
+	for t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+
+	switch t {
+	case timeType:
+		return map[string]interface{}{"type": "string", "format": "date-time"}
+	case durationType:
+		return map[string]interface{}{"type": "integer"}
+	case rawMessageType:
+		return map[string]interface{}{}
+	}
+
+	switch t.Kind() {
+	case reflect.String:
+		return map[string]interface{}{"type": "string"}
+	case reflect.Bool:
+		return map[string]interface{}{"type": "boolean"}
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return map[string]interface{}{"type": "integer"}
+	case reflect.Float32, reflect.Float64:
+		return map[string]interface{}{"type": "number"}
+	case reflect.Slice, reflect.Array:
+		if t.Elem().Kind() == reflect.Uint8 {
+			return map[string]interface{}{"type": "string", "contentEncoding": "base64"}
+		}
+		return map[string]interface{}{"type": "array", "items": b.schema(t.Elem())}
+	case reflect.Map:
+		return map[string]interface{}{"type": "object", "additionalProperties": b.schema(t.Elem())}
+	case reflect.Struct:
+		if b.visiting[t] {
+			b.recursive[t] = true
+			return map[string]interface{}{"$ref": "#/$defs/" + schemaName(t)}
+		}
+
+		b.visiting[t] = true
+		schema := b.structSchema(t)
+		delete(b.visiting, t)
+
+		if b.recursive[t] {
+			b.defs[schemaName(t)] = schema
+			return map[string]interface{}{"$ref": "#/$defs/" + schemaName(t)}
+		}
+		return schema
+	}
+
+	return map[string]interface{}{}
+}
+
+// nilable reports whether values of t may be encoded as null.
+func nilable(t reflect.Type) bool {
+	switch t.Kind() {
+	case reflect.Ptr, reflect.Map, reflect.Slice, reflect.Interface:
+		return true
+	}
+	return false
+}
+
+// nullable returns schema extended to also accept null.
+func nullable(schema map[string]interface{}) map[string]interface{} {
+	if typ, ok := schema["type"].(string); ok {
+		schema["type"] = []string{typ, "null"}
+		return schema
+	}
+	if _, ok := schema["$ref"]; ok {
+		return map[string]interface{}{"anyOf": []interface{}{schema, map[string]interface{}{"type": "null"}}}
+	}
+	return schema
+}
+
+// schemaName returns the "$defs" name of a struct type.
+func schemaName(t reflect.Type) string {
+	if t.Name() != "" {
+		return t.Name()
+	}
+	return strings.NewReplacer(" ", "", "\"", "", ";", "_").Replace(t.String())
+}
+
+func (b *schemaBuilder) structSchema(t reflect.Type) map[string]interface{} {
+	properties := make(map[string]interface{})
+	var required []string
+
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		if !field.IsExported() {
+			continue
+		}
+
+		name := field.Name
+		omitempty := false
+		if tag, ok := field.Tag.Lookup("json"); ok {
+			parts := strings.Split(tag, ",")
+			if parts[0] == "-" {
+				continue
+			}
+			if parts[0] != "" {
+				name = parts[0]
+			}
+			for _, option := range parts[1:] {
+				if option == "omitempty" {
+					omitempty = true
+				}
+			}
+		}
+
+		embeddedType := field.Type
+		if embeddedType.Kind() == reflect.Ptr {
+			embeddedType = embeddedType.Elem()
+		}
+		if field.Anonymous && embeddedType.Kind() == reflect.Struct && name == field.Name {
+			embedded := b.structSchema(embeddedType)
+			for key, value := range embedded["properties"].(map[string]interface{}) {
+				properties[key] = value
+			}
+			if fields, ok := embedded["required"].([]string); ok {
+				required = append(required, fields...)
+			}
+			continue
+		}
+
+		schema := b.schema(field.Type)
+		if !omitempty && nilable(field.Type) {
+			// Nil pointers, maps and slices are encoded as null.
+			schema = nullable(schema)
+		}
+		if description := field.Tag.Get("extract"); description != "" {
+			schema["description"] = description
+		}
+		properties[name] = schema
+
+		if !omitempty && field.Type.Kind() != reflect.Ptr {
+			required = append(required, name)
+		}
+	}
+
+	schema := map[string]interface{}{
+		"type":       "object",
+		"properties": properties,
+	}
+	if len(required) > 0 {
+		schema["required"] = required
+	}
+	return schema
+}
@@ -0,0 +1,131 @@
+package documentstack
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/json"
+	"fmt"
+	"strings"
+)
+
+// encryptedFieldKey marks an encrypted value in template data.
+const encryptedFieldKey = "$encrypted"
+
+// FieldEncryption encrypts sensitive template data fields on the client, so
+// their plaintext never appears in request bodies, logs or proxies. The
+// template engine decrypts them server-side with the registered key.
+//
+// Each request uses a fresh 256-bit data key. Field values are encrypted with
+// the data key using AES-256-GCM, and the data key is wrapped with Key, the
+// material of an EncryptionKeysService key of type KeyUploaded. An encrypted
+// field is replaced by
+//
+//	{"$encrypted": {"keyId": "...", "wrappedKey": "...", "nonce": "...", "ciphertext": "..."}}
+//
+// with base64-encoded binary values.
+//
+// Field encryption applies to the data sent by Client.Generate,
+// Client.GenerateStream, Client.GenerateInto, PreparedRequest and
+// JobsService.Submit. Other payloads, such as the data of
+// Client.EvaluateExpression and Schedule.Data, are sent as given.
+//
+// Example:
+//
+//	client, err := documentstack.New(documentstack.Config{
+//		APIKey: apiKey,
+//		FieldEncryption: &documentstack.FieldEncryption{
+//			KeyID: "key_123",
+//			Key:   keyMaterial,
+//			Paths: []string{"customer.taxId", "payment.iban"},
+//		},
+//	})
+type FieldEncryption struct {
+	// KeyID is the ID of the registered key.
+	KeyID string
+
+	// Key is the 32-byte key material of KeyID.
+	Key []byte
+
+	// Paths are the dot-separated data paths to encrypt, e.g. "customer.taxId".
+	// Each segment is an object key; paths into arrays, such as a field of
+	// every item, are not supported. Missing paths are ignored.
+	Paths []string
+}
+
+// encryptedField is the envelope that replaces an encrypted value.
+type encryptedField struct {
+	KeyID      string `json:"keyId"`
+	WrappedKey []byte `json:"wrappedKey"`
+	Nonce      []byte `json:"nonce"`
+	Ciphertext []byte `json:"ciphertext"`
+}
+
+// encrypt returns a copy of data with the configured paths encrypted.
+func (e *FieldEncryption) encrypt(data map[string]interface{}) (map[string]interface{}, error) {
+	if len(e.Key) != 32 {
+		return nil, NewValidationError("Field encryption key must be 32 bytes", nil)
+	}
+
+	dataKey := make([]byte, 32)
+	if _, err := rand.Read(dataKey); err != nil {
+		return nil, &DocumentStackError{Message: fmt.Sprintf("failed to generate data key: %v", err)}
+	}
+
+	wrappedKey, err := seal(e.Key, dataKey)
+	if err != nil {
+		return nil, err
+	}
+
+	encrypted := copyData(data)
+	for _, path := range e.Paths {
+		keys := strings.Split(path, ".")
+		value, ok := removePath(encrypted, keys)
+		if !ok {
+			continue
+		}
+
+		plaintext, err := json.Marshal(value)
+		if err != nil {
+			return nil, &DocumentStackError{Message: fmt.Sprintf("failed to encode field %q: %v", path, err)}
+		}
+
+		sealed, err := seal(dataKey, plaintext)
+		if err != nil {
+			return nil, err
+		}
+
+		field := encryptedField{
+			KeyID:      e.KeyID,
+			WrappedKey: wrappedKey,
+			Nonce:      sealed[:gcmNonceSize],
+			Ciphertext: sealed[gcmNonceSize:],
+		}
+		setPath(encrypted, keys, map[string]interface{}{encryptedFieldKey: field})
+	}
+
+	return encrypted, nil
+}
+
+const gcmNonceSize = 12
+
+// seal encrypts plaintext with AES-256-GCM under key and returns the nonce
+// followed by the ciphertext.
+func seal(key, plaintext []byte) ([]byte, error) {
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, &DocumentStackError{Message: fmt.Sprintf("failed to create cipher: %v", err)}
+	}
+
+	gcm, err := cipher.NewGCM(block)
+	if err != nil {
+		return nil, &DocumentStackError{Message: fmt.Sprintf("failed to create cipher: %v", err)}
+	}
+
+	nonce := make([]byte, gcmNonceSize)
+	if _, err := rand.Read(nonce); err != nil {
+		return nil, &DocumentStackError{Message: fmt.Sprintf("failed to generate nonce: %v", err)}
+	}
+
+	return gcm.Seal(nonce, nonce, plaintext, nil), nil
+}
@@ -0,0 +1,113 @@
+package documentstack
+
+import (
+	"context"
+	"log"
+	"net/http"
+	"time"
+)
+
+type hedgeKey struct{}
+
+// WithHedging returns a context that enables request hedging for calls made
+// with it: if a GET request has not completed after delay, a second identical
+// request is sent and the first successful response wins. The other request is
+// cancelled. A good delay is the operation's observed P95 latency.
+//
+// Hedging only applies to GET and HEAD requests, such as previews and status
+// polling; other calls ignore it.
+//
+// Example:
+//
+//	ctx := documentstack.WithHedging(ctx, 300*time.Millisecond)
+//	template, err := client.Templates.Get(ctx, "invoice")
+func WithHedging(ctx context.Context, delay time.Duration) context.Context {
+	return context.WithValue(ctx, hedgeKey{}, delay)
+}
+
+// hedgeDelay returns the hedging delay configured on ctx for req, if any.
+func hedgeDelay(ctx context.Context, req *http.Request) (time.Duration, bool) {
+	if req.Method != http.MethodGet && req.Method != http.MethodHead {
+		return 0, false
+	}
+	delay, ok := ctx.Value(hedgeKey{}).(time.Duration)
+	return delay, ok && delay > 0
+}
+
+// hedgedAttempt runs req and, if it has not completed after delay, a second
+// copy of it, returning the first successful response.
+func (c *Client) hedgedAttempt(ctx context.Context, req *http.Request, delay time.Duration) (*http.Response, error) {
+	type result struct {
+		index int
+		resp  *http.Response
+		err   error
+	}
+
+	results := make(chan result, 2)
+	var cancels []context.CancelFunc
+
+	launch := func() {
+		attemptCtx, cancel := context.WithCancel(ctx)
+		index := len(cancels)
+		cancels = append(cancels, cancel)
+
+		go func() {
+			resp, err := c.attempt(attemptCtx, req.Clone(attemptCtx))
+			results <- result{index: index, resp: resp, err: err}
+		}()
+	}
+
+	launch()
+	timer := time.NewTimer(delay)
+	defer timer.Stop()
+
+	pending := 1
+	var firstErr error
+
+	for {
+		select {
+		case <-timer.C:
+			if len(cancels) == 1 {
+				if c.config.Debug {
+					log.Printf("[DocumentStack] Hedging %s %s after %s\n", req.Method, req.URL, delay)
+				}
+				launch()
+				pending++
+			}
+
+		case r := <-results:
+			pending--
+
+			if r.err == nil {
+				for i, cancel := range cancels {
+					if i != r.index {
+						cancel()
+					}
+				}
+				if pending > 0 {
+					// Release the losing response, if it still succeeds.
+					go func() {
+						if loser := <-results; loser.err == nil {
+							loser.resp.Body.Close()
+						}
+					}()
+				}
+				r.resp.Body = &cancelOnClose{ReadCloser: r.resp.Body, cancel: cancels[r.index]}
+				return r.resp, nil
+			}
+
+			cancels[r.index]()
+			if firstErr == nil {
+				firstErr = r.err
+			}
+
+			// Every attempt sent so far has failed; retries handle the rest.
+			if pending == 0 {
+				for _, cancel := range cancels {
+					cancel()
+				}
+				return nil, firstErr
+			}
+		}
+	}
+}
@@ -0,0 +1,79 @@
+package documentstack
+
+import "context"
+
+// ImageSource is a single image assembled into a PDF page. Exactly one of
+// Data, AssetID and URL must be set.
+type ImageSource struct {
+	// Data is the JPEG, PNG or TIFF image content, sent inline.
+	Data []byte `json:"data,omitempty"`
+
+	// AssetID is the ID of an uploaded image asset.
+	AssetID string `json:"assetId,omitempty"`
+
+	// URL is a publicly reachable URL the API downloads the image from.
+	URL string `json:"url,omitempty"`
+
+	// PageSize overrides ImagesOptions.PageSize for this image.
+	PageSize string `json:"pageSize,omitempty"`
+
+	// Rotation rotates the image clockwise by 0, 90, 180 or 270 degrees.
+	Rotation int `json:"rotation,omitempty"`
+}
+
+// ImagesOptions controls how images are assembled into a PDF.
+type ImagesOptions struct {
+	// Filename is the custom filename for the PDF (without .pdf extension).
+	Filename string `json:"filename,omitempty"`
+
+	// PageSize is the page size, e.g. "A4" or "Letter", or "fit" to size each
+	// page to its image.
+	// Default: "fit"
+	PageSize string `json:"pageSize,omitempty"`
+
+	// MarginMm is the page margin in millimeters.
+	MarginMm float64 `json:"marginMm,omitempty"`
+}
+
+// ImagesToPDF assembles images into a single PDF with one page per image, in
+// order. opts may be nil.
+//
+// Example:
+//
+//	result, err := client.ImagesToPDF(ctx, []documentstack.ImageSource{
+//		{Data: scan1},
+//		{Data: scan2, Rotation: 90},
+//	}, &documentstack.ImagesOptions{PageSize: "A4"})
+func (c *Client) ImagesToPDF(ctx context.Context, images []ImageSource, opts *ImagesOptions) (*GenerateResponse, error) {
+	if len(images) == 0 {
+		return nil, NewValidationError("At least one image is required", nil)
+	}
+
+	for _, image := range images {
+		set := 0
+		if len(image.Data) > 0 {
+			set++
+		}
+		if image.AssetID != "" {
+			set++
+		}
+		if image.URL != "" {
+			set++
+		}
+		if set != 1 {
+			return nil, NewValidationError("Image must set exactly one of Data, AssetID or URL", nil)
+		}
+
+		switch image.Rotation {
+		case 0, 90, 180, 270:
+		default:
+			return nil, NewValidationError("Image rotation must be 0, 90, 180 or 270", nil)
+		}
+	}
+
+	body := map[string]interface{}{
+		"images":  images,
+		"options": opts,
+	}
+	return c.postPDF(ctx, "/api/v1/convert/images", body)
+}
@@ -0,0 +1,73 @@
+package documentstack
+
+import "context"
+
+// PageSize is the size of a page in PDF points (1/72 inch).
+type PageSize struct {
+	Width  float64 `json:"width"`
+	Height float64 `json:"height"`
+}
+
+// FontInfo describes a font used in a document.
+type FontInfo struct {
+	Name     string `json:"name"`
+	Type     string `json:"type"`
+	Embedded bool   `json:"embedded"`
+	Subset   bool   `json:"subset"`
+}
+
+// AttachmentInfo describes a file embedded in a document.
+type AttachmentInfo struct {
+	Name        string `json:"name"`
+	ContentType string `json:"contentType,omitempty"`
+	Size        int64  `json:"size"`
+}
+
+// DocumentInfo is the structural metadata of a document.
+type DocumentInfo struct {
+	// PageCount is the number of pages.
+	PageCount int `json:"pageCount"`
+
+	// PageSizes are the sizes of each page, in page order.
+	PageSizes []PageSize `json:"pageSizes"`
+
+	// Encrypted reports whether the document is encrypted.
+	Encrypted bool `json:"encrypted"`
+
+	// PDFVersion is the PDF version, e.g. "1.7".
+	PDFVersion string `json:"pdfVersion"`
+
+	// Conformance lists the standards the document claims to conform to, e.g. "PDF/A-2b" or "PDF/UA-1".
+	Conformance []string `json:"conformance,omitempty"`
+
+	// Fonts are the fonts used in the document.
+	Fonts []FontInfo `json:"fonts,omitempty"`
+
+	// Attachments are the files embedded in the document.
+	Attachments []AttachmentInfo `json:"attachments,omitempty"`
+
+	// Metadata is the document information dictionary, e.g. "Title" and "Author".
+	Metadata map[string]string `json:"metadata,omitempty"`
+}
+
+// Inspect returns structural metadata about a document, such as its page
+// count and page sizes, without modifying it.
+//
+// Example:
+//
+//	info, err := client.Inspect(ctx, documentstack.SourceFromBytes(result.PDF))
+//	if err == nil {
+//		postage := postageFor(info.PageCount)
+//	}
+func (c *Client) Inspect(ctx context.Context, source *Source) (*DocumentInfo, error) {
+	if err := source.validate(); err != nil {
+		return nil, err
+	}
+
+	var result DocumentInfo
+	if err := c.queryJSON(ctx, "/api/v1/inspect", map[string]interface{}{"source": source}, &result); err != nil {
+		return nil, err
+	}
+
+	return &result, nil
+}
@@ -0,0 +1,292 @@
+package documentstack
+
+import (
+	"context"
+	"log"
+	"sync"
+	"time"
+)
+
+const defaultJobPollInterval = 5 * time.Second
+
+// JobCallback is called once a tracked job has completed or failed.
+type JobCallback func(ctx context.Context, tracked *TrackedJob, job *Job)
+
+// TrackedJob is a job persisted in a JobStore until it finishes.
+type TrackedJob struct {
+	ID string `json:"id"`
+
+	// Metadata is application data stored with the job, e.g. the ID of the
+	// order the document belongs to, for use in callbacks after a restart.
+	Metadata map[string]string `json:"metadata,omitempty"`
+
+	TrackedAt time.Time `json:"trackedAt"`
+}
+
+// JobStore persists tracked jobs so they survive process restarts.
+// Implementations must be safe for concurrent use.
+type JobStore interface {
+	// Save inserts or replaces job.
+	Save(ctx context.Context, job *TrackedJob) error
+
+	// Delete removes the job with the given ID. Deleting a missing job is not an error.
+	Delete(ctx context.Context, id string) error
+
+	// Pending returns all stored jobs.
+	Pending(ctx context.Context) ([]*TrackedJob, error)
+}
+
+// JobManagerOptions controls how a JobManager polls jobs.
+type JobManagerOptions struct {
+	// PollInterval is the time between polls.
+	// Default: 5s
+	PollInterval time.Duration
+
+	// OnComplete is called for finished jobs that were tracked without a
+	// callback, including jobs recovered from the store after a restart.
+	OnComplete JobCallback
+
+	// OnMissing, if set, receives tracked jobs that the API no longer
+	// returns, e.g. because they expired or the ID is unknown, before they
+	// stop being tracked. Without OnMissing they are logged.
+	OnMissing func(ctx context.Context, tracked *TrackedJob)
+}
+
+// JobManager tracks many asynchronous jobs, polls their status in batches and
+// invokes a callback when each one finishes. Tracked jobs are persisted in a
+// JobStore, so a new manager resumes tracking after a crash.
+//
+// Example:
+//
+//	manager := documentstack.NewJobManager(client, documentstack.NewMemoryJobStore(), &documentstack.JobManagerOptions{
+//		OnComplete: func(ctx context.Context, tracked *documentstack.TrackedJob, job *documentstack.Job) {
+//			markReady(ctx, tracked.Metadata["orderId"], job)
+//		},
+//	})
+//	go manager.Run(ctx)
+//
+//	job, err := manager.Submit(ctx, "invoice", request, map[string]string{"orderId": order.ID}, nil)
+type JobManager struct {
+	client *Client
+	store  JobStore
+	opts   JobManagerOptions
+
+	mu        sync.Mutex
+	callbacks map[string]JobCallback
+}
+
+// NewJobManager creates a job manager that polls with client and persists
+// tracked jobs in store.
+func NewJobManager(client *Client, store JobStore, opts *JobManagerOptions) *JobManager {
+	manager := &JobManager{
+		client:    client,
+		store:     store,
+		callbacks: make(map[string]JobCallback),
+	}
+	if opts != nil {
+		manager.opts = *opts
+	}
+	if manager.opts.PollInterval <= 0 {
+		manager.opts.PollInterval = defaultJobPollInterval
+	}
+	return manager
+}
+
+// Submit submits an asynchronous generation and tracks the job. callback may
+// be nil to use JobManagerOptions.OnComplete. If the job was submitted but
+// could not be saved to the store, it is returned with the error, so it can
+// be tracked with Track instead of being submitted again.
+func (m *JobManager) Submit(ctx context.Context, templateID string, request *GenerateRequest, metadata map[string]string, callback JobCallback) (*Job, error) {
+	job, err := m.client.Jobs.Submit(ctx, templateID, request)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := m.Track(ctx, job.ID, metadata, callback); err != nil {
+		return job, err
+	}
+
+	return job, nil
+}
+
+// Track starts tracking a submitted job. callback may be nil to use
+// JobManagerOptions.OnComplete. Callbacks are not persisted: after a restart
+// recovered jobs are reported to OnComplete.
+func (m *JobManager) Track(ctx context.Context, jobID string, metadata map[string]string, callback JobCallback) error {
+	if jobID == "" {
+		return NewValidationError("Job ID is required", nil)
+	}
+
+	if callback != nil {
+		m.mu.Lock()
+		m.callbacks[jobID] = callback
+		m.mu.Unlock()
+	}
+
+	return m.store.Save(ctx, &TrackedJob{
+		ID:        jobID,
+		Metadata:  metadata,
+		TrackedAt: time.Now().UTC(),
+	})
+}
+
+// Run polls the tracked jobs every PollInterval until ctx is done or the
+// client is closed, and returns ctx's error or ErrClientClosed. Failed polls
+// are retried at the next interval; store failures are returned.
+func (m *JobManager) Run(ctx context.Context) error {
+	ctx, done, err := m.client.lifecycle.begin(ctx)
+	if err != nil {
+		return err
+	}
+	defer done()
+
+	ticker := time.NewTicker(m.opts.PollInterval)
+	defer ticker.Stop()
+
+	for {
+		if err := m.poll(ctx); err != nil {
+			return err
+		}
+
+		select {
+		case <-ticker.C:
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-m.client.lifecycle.closing.Done():
+			return ErrClientClosed
+		}
+	}
+}
+
+// poll fetches the status of all tracked jobs and reports finished ones.
+func (m *JobManager) poll(ctx context.Context) error {
+	tracked, err := m.store.Pending(ctx)
+	if err != nil {
+		return err
+	}
+
+	for start := 0; start < len(tracked); start += MaxJobsPerRequest {
+		batch := tracked[start:min(start+MaxJobsPerRequest, len(tracked))]
+
+		ids := make([]string, len(batch))
+		byID := make(map[string]*TrackedJob, len(batch))
+		for i, job := range batch {
+			ids[i] = job.ID
+			byID[job.ID] = job
+		}
+
+		jobs, err := m.client.Jobs.GetMany(ctx, ids)
+		if err != nil {
+			if ctx.Err() != nil {
+				return nil
+			}
+			if m.client.config.Debug {
+				log.Printf("[DocumentStack] Polling jobs failed: %v\n", err)
+			}
+			continue
+		}
+
+		for _, job := range jobs {
+			trackedJob, ok := byID[job.ID]
+			if !ok {
+				continue
+			}
+			delete(byID, job.ID)
+			if !job.Done() {
+				continue
+			}
+			if err := m.finish(ctx, trackedJob, job); err != nil {
+				return err
+			}
+		}
+
+		for _, id := range ids {
+			if trackedJob, ok := byID[id]; ok {
+				if err := m.missing(ctx, trackedJob); err != nil {
+					return err
+				}
+			}
+		}
+	}
+
+	return nil
+}
+
+// missing reports and stops tracking a job the API did not return. Jobs
+// tracked within the last PollInterval are kept, as a just submitted job may
+// not be visible yet.
+func (m *JobManager) missing(ctx context.Context, tracked *TrackedJob) error {
+	if time.Since(tracked.TrackedAt) < m.opts.PollInterval {
+		return nil
+	}
+
+	m.mu.Lock()
+	delete(m.callbacks, tracked.ID)
+	m.mu.Unlock()
+
+	if m.opts.OnMissing != nil {
+		m.opts.OnMissing(ctx, tracked)
+	} else {
+		log.Printf("[DocumentStack] Tracked job %s no longer exists\n", tracked.ID)
+	}
+
+	return m.store.Delete(ctx, tracked.ID)
+}
+
+// finish invokes the callback for a finished job and stops tracking it.
+func (m *JobManager) finish(ctx context.Context, tracked *TrackedJob, job *Job) error {
+	m.mu.Lock()
+	callback, ok := m.callbacks[job.ID]
+	delete(m.callbacks, job.ID)
+	m.mu.Unlock()
+
+	if !ok {
+		callback = m.opts.OnComplete
+	}
+	if callback != nil {
+		callback(ctx, tracked, job)
+	}
+
+	return m.store.Delete(ctx, job.ID)
+}
+
+// MemoryJobStore is an in-memory JobStore. Jobs do not survive restarts; it
+// is intended for development and tests.
+type MemoryJobStore struct {
+	mu   sync.Mutex
+	jobs map[string]TrackedJob
+}
+
+// NewMemoryJobStore creates an empty in-memory job store.
+func NewMemoryJobStore() *MemoryJobStore {
+	return &MemoryJobStore{jobs: make(map[string]TrackedJob)}
+}
+
+// Save implements JobStore.
+func (s *MemoryJobStore) Save(ctx context.Context, job *TrackedJob) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.jobs[job.ID] = *job
+	return nil
+}
+
+// Delete implements JobStore.
+func (s *MemoryJobStore) Delete(ctx context.Context, id string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	delete(s.jobs, id)
+	return nil
+}
+
+// Pending implements JobStore.
+func (s *MemoryJobStore) Pending(ctx context.Context) ([]*TrackedJob, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	jobs := make([]*TrackedJob, 0, len(s.jobs))
+	for _, job := range s.jobs {
+		job := job
+		jobs = append(jobs, &job)
+	}
+	return jobs, nil
+}
@@ -0,0 +1,146 @@
+package documentstack
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"strings"
+	"time"
+)
+
+// JobStatus is the state of an asynchronous generation job.
+type JobStatus string
+
+// Job statuses.
+const (
+	JobQueued    JobStatus = "queued"
+	JobRunning   JobStatus = "running"
+	JobCompleted JobStatus = "completed"
+	JobFailed    JobStatus = "failed"
+)
+
+// JobsService submits and tracks asynchronous generation jobs, for documents
+// that take too long to render within a single request.
+type JobsService struct {
+	client *Client
+}
+
+// Job is an asynchronous generation job.
+type Job struct {
+	ID         string    `json:"id"`
+	TemplateID string    `json:"templateId"`
+	Status     JobStatus `json:"status"`
+
+	// OnBehalfOf is the customer ID the job was submitted for. See WithOnBehalfOf.
+	OnBehalfOf string `json:"onBehalfOf,omitempty"`
+
+	// Error describes why the job failed, if Status is JobFailed.
+	Error *APIErrorResponse `json:"error,omitempty"`
+
+	// QueueWaitMs and GenerationTimeMs are the time spent waiting in the render
+	// queue and rendering, in milliseconds, once the job has completed.
+	QueueWaitMs      int64 `json:"queueWaitMs,omitempty"`
+	GenerationTimeMs int64 `json:"generationTimeMs,omitempty"`
+
+	// Region is the API region that rendered the document.
+	Region string `json:"region,omitempty"`
+
+	// Warnings are problems that did not prevent the job from completing.
+	// See GenerateResponse.Warnings.
+	Warnings []Warning `json:"warnings,omitempty"`
+
+	CreatedAt   time.Time  `json:"createdAt"`
+	CompletedAt *time.Time `json:"completedAt,omitempty"`
+}
+
+// Done reports whether the job has completed or failed.
+func (j *Job) Done() bool {
+	return j.Status == JobCompleted || j.Status == JobFailed
+}
+
+// Submit starts an asynchronous generation. Poll the job with Get, or track it
+// with a JobManager, and fetch the PDF with Download once it has completed.
+func (s *JobsService) Submit(ctx context.Context, templateID string, request *GenerateRequest) (*Job, error) {
+	if templateID == "" {
+		return nil, NewValidationError("Template ID is required", nil)
+	}
+
+	request, err := s.client.prepareRequest(templateID, request)
+	if err != nil {
+		return nil, err
+	}
+
+	body := struct {
+		TemplateID string `json:"templateId"`
+		*GenerateRequest
+	}{
+		TemplateID:      templateID,
+		GenerateRequest: request,
+	}
+
+	var result Job
+	if err := s.client.doJSON(ctx, "POST", "/api/v1/jobs", body, &result); err != nil {
+		return nil, err
+	}
+
+	return &result, nil
+}
+
+// Get retrieves a job, including its current status.
+func (s *JobsService) Get(ctx context.Context, jobID string) (*Job, error) {
+	if jobID == "" {
+		return nil, NewValidationError("Job ID is required", nil)
+	}
+
+	var result Job
+	endpoint := fmt.Sprintf("/api/v1/jobs/%s", url.PathEscape(jobID))
+	if err := s.client.doJSON(ctx, "GET", endpoint, nil, &result); err != nil {
+		return nil, err
+	}
+
+	return &result, nil
+}
+
+// Download fetches the PDF of a completed job.
+func (s *JobsService) Download(ctx context.Context, jobID string) (*GenerateResponse, error) {
+	if jobID == "" {
+		return nil, NewValidationError("Job ID is required", nil)
+	}
+
+	return s.client.getPDF(ctx, fmt.Sprintf("/api/v1/jobs/%s/document", url.PathEscape(jobID)))
+}
+
+// MaxJobsPerRequest is the maximum number of jobs the API returns per status request.
+const MaxJobsPerRequest = 100
+
+// GetMany retrieves the status of several jobs, using one request per
+// MaxJobsPerRequest IDs instead of one per job. Unknown IDs are omitted from
+// the result.
+//
+// Example:
+//
+//	jobs, err := client.Jobs.GetMany(ctx, pendingIDs)
+//	for _, job := range jobs {
+//		if job.Done() {
+//			// ...
+//		}
+//	}
+func (s *JobsService) GetMany(ctx context.Context, jobIDs []string) ([]*Job, error) {
+	var all []*Job
+
+	for start := 0; start < len(jobIDs); start += MaxJobsPerRequest {
+		batch := jobIDs[start:min(start+MaxJobsPerRequest, len(jobIDs))]
+
+		var result struct {
+			Jobs []*Job `json:"jobs"`
+		}
+		endpoint := "/api/v1/jobs?ids=" + url.QueryEscape(strings.Join(batch, ","))
+		if err := s.client.doJSON(ctx, "GET", endpoint, nil, &result); err != nil {
+			return nil, err
+		}
+
+		all = append(all, result.Jobs...)
+	}
+
+	return all, nil
+}
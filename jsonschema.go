@@ -0,0 +1,280 @@
+package documentstack
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"math"
+	"reflect"
+	"sort"
+	"strings"
+	"time"
+)
+
+// jsonSchemaDialect is the JSON Schema version of exported schemas.
+const jsonSchemaDialect = "https://json-schema.org/draft/2020-12/schema"
+
+// JSONSchema returns a JSON Schema describing the JSON encoding of v's type,
+// e.g. JSONSchema(&GenerateRequest{}), so services written in other languages
+// can construct payloads that a Go service forwards with this SDK. Fields
+// without omitempty are required, and may be null if they are pointers, maps
+// or slices.
+func JSONSchema(v interface{}) map[string]interface{} {
+	schema := jsonSchemaFor(reflect.TypeOf(v))
+	schema["$schema"] = jsonSchemaDialect
+	return schema
+}
+
+// requestSchemaTypes are the types RequestSchemas describes.
+var requestSchemaTypes = []interface{}{
+	&GenerateRequest{},
+	&GenerateOptions{},
+	&DataLayer{},
+	&DocumentMetadata{},
+	&PrintRequest{},
+}
+
+// RequestSchemas returns the JSON Schemas of the SDK's main request types,
+// keyed by type name, e.g. "GenerateRequest" and "GenerateOptions".
+//
+// Example:
+//
+//	for name, schema := range documentstack.RequestSchemas() {
+//		data, _ := json.MarshalIndent(schema, "", "  ")
+//		os.WriteFile(name+".schema.json", data, 0644)
+//	}
+func RequestSchemas() map[string]map[string]interface{} {
+	schemas := make(map[string]map[string]interface{}, len(requestSchemaTypes))
+	for _, v := range requestSchemaTypes {
+		schemas[reflect.TypeOf(v).Elem().Name()] = JSONSchema(v)
+	}
+	return schemas
+}
+
+// ValidateJSON validates data against schema, as returned by JSONSchema, and
+// returns a validation *APIError listing every violation in its
+// FieldViolations. It supports the keywords used by JSONSchema: type,
+// properties, required, items, additionalProperties, enum, anyOf, format
+// "date-time", contentEncoding "base64" and local "$ref"s.
+//
+// Example:
+//
+//	if err := documentstack.ValidateJSON(documentstack.JSONSchema(&documentstack.GenerateRequest{}), body); err != nil {
+//		http.Error(w, err.Error(), http.StatusBadRequest)
+//		return
+//	}
+func ValidateJSON(schema map[string]interface{}, data []byte) error {
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.UseNumber()
+
+	var value interface{}
+	if err := decoder.Decode(&value); err != nil {
+		return NewValidationError(fmt.Sprintf("Invalid JSON: %v", err), nil)
+	}
+
+	v := &schemaValidator{root: schema}
+	v.validate(schema, value, "")
+
+	if len(v.violations) > 0 {
+		return NewValidationError(fmt.Sprintf("JSON does not match schema: %s: %s", v.violations[0].Path, v.violations[0].Message), &ErrorDetails{FieldViolations: v.violations})
+	}
+	return nil
+}
+
+// schemaValidator collects the violations of a value against a schema.
+type schemaValidator struct {
+	root       map[string]interface{}
+	violations []FieldViolation
+}
+
+func (v *schemaValidator) fail(path, code, format string, args ...interface{}) {
+	if path == "" {
+		path = "$"
+	}
+	v.violations = append(v.violations, FieldViolation{Path: path, Code: code, Message: fmt.Sprintf(format, args...)})
+}
+
+func (v *schemaValidator) validate(schema map[string]interface{}, value interface{}, path string) {
+	if ref, ok := schema["$ref"].(string); ok {
+		resolved, found := v.resolve(ref)
+		if !found {
+			v.fail(path, "invalid_schema", "unresolved reference %q", ref)
+			return
+		}
+		schema = resolved
+	}
+
+	if enum, ok := schema["enum"].([]interface{}); ok && !inEnum(enum, value) {
+		v.fail(path, "invalid_value", "must be one of %v", enum)
+	}
+
+	if anyOf, ok := schema["anyOf"].([]interface{}); ok {
+		v.validateAnyOf(anyOf, value, path)
+	}
+
+	types := stringList(schema["type"])
+	if typ, ok := schema["type"].(string); ok {
+		types = []string{typ}
+	}
+	if len(types) == 0 {
+		return
+	}
+	typ := ""
+	for _, candidate := range types {
+		if hasJSONType(value, candidate) {
+			typ = candidate
+			break
+		}
+	}
+	if typ == "" {
+		v.fail(path, "invalid_type", "must be of type %s", strings.Join(types, " or "))
+		return
+	}
+
+	switch typ {
+	case "string":
+		s := value.(string)
+		if schema["format"] == "date-time" {
+			if _, err := time.Parse(time.RFC3339, s); err != nil {
+				v.fail(path, "invalid_format", "must be an RFC 3339 date-time")
+			}
+		}
+		if schema["contentEncoding"] == "base64" {
+			if _, err := base64.StdEncoding.DecodeString(s); err != nil {
+				v.fail(path, "invalid_format", "must be base64-encoded")
+			}
+		}
+
+	case "array":
+		if items, ok := schema["items"].(map[string]interface{}); ok {
+			for i, item := range value.([]interface{}) {
+				v.validate(items, item, fmt.Sprintf("%s[%d]", path, i))
+			}
+		}
+
+	case "object":
+		object := value.(map[string]interface{})
+		for _, name := range stringList(schema["required"]) {
+			if _, ok := object[name]; !ok {
+				v.fail(joinPath(path, name), "required", "is required")
+			}
+		}
+
+		properties, _ := schema["properties"].(map[string]interface{})
+		for _, name := range sortedKeys(object) {
+			if property, ok := properties[name].(map[string]interface{}); ok {
+				v.validate(property, object[name], joinPath(path, name))
+				continue
+			}
+			switch additional := schema["additionalProperties"].(type) {
+			case map[string]interface{}:
+				v.validate(additional, object[name], joinPath(path, name))
+			case bool:
+				if !additional {
+					v.fail(joinPath(path, name), "unknown_field", "is not allowed")
+				}
+			}
+		}
+	}
+}
+
+// validateAnyOf records a violation unless value matches one of the schemas.
+func (v *schemaValidator) validateAnyOf(schemas []interface{}, value interface{}, path string) {
+	var first []FieldViolation
+	for _, candidate := range schemas {
+		schema, ok := candidate.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		branch := &schemaValidator{root: v.root}
+		branch.validate(schema, value, path)
+		if len(branch.violations) == 0 {
+			return
+		}
+		if first == nil {
+			first = branch.violations
+		}
+	}
+	v.violations = append(v.violations, first...)
+}
+
+// resolve returns the schema referenced by a local "#/$defs/Name" reference.
+func (v *schemaValidator) resolve(ref string) (map[string]interface{}, bool) {
+	name, ok := strings.CutPrefix(ref, "#/$defs/")
+	if !ok {
+		return nil, false
+	}
+
+	defs, _ := v.root["$defs"].(map[string]interface{})
+	resolved, ok := defs[name].(map[string]interface{})
+	return resolved, ok
+}
+
+// hasJSONType reports whether a value decoded with UseNumber has the JSON
+// Schema type typ.
+func hasJSONType(value interface{}, typ string) bool {
+	switch typ {
+	case "string":
+		_, ok := value.(string)
+		return ok
+	case "boolean":
+		_, ok := value.(bool)
+		return ok
+	case "number":
+		_, ok := value.(json.Number)
+		return ok
+	case "integer":
+		n, ok := value.(json.Number)
+		if !ok {
+			return false
+		}
+		f, err := n.Float64()
+		return err == nil && f == math.Trunc(f)
+	case "array":
+		_, ok := value.([]interface{})
+		return ok
+	case "object":
+		_, ok := value.(map[string]interface{})
+		return ok
+	case "null":
+		return value == nil
+	}
+	return true
+}
+
+func inEnum(enum []interface{}, value interface{}) bool {
+	for _, allowed := range enum {
+		if fmt.Sprint(allowed) == fmt.Sprint(value) {
+			return true
+		}
+	}
+	return false
+}
+
+// stringList returns a list of strings from a schema keyword, which is a
+// []string in schemas built by JSONSchema and a []interface{} in decoded ones.
+func stringList(value interface{}) []string {
+	switch list := value.(type) {
+	case []string:
+		return list
+	case []interface{}:
+		strs := make([]string, 0, len(list))
+		for _, item := range list {
+			if s, ok := item.(string); ok {
+				strs = append(strs, s)
+			}
+		}
+		sort.Strings(strs)
+		return strs
+	}
+	return nil
+}
+
+func joinPath(path, name string) string {
+	if path == "" {
+		return name
+	}
+	return path + "." + name
+}
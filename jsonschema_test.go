@@ -0,0 +1,43 @@
+package documentstack
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestRequestSchemasRoundTrip(t *testing.T) {
+	values := []interface{}{
+		&DataLayer{Name: "tenant"},
+		&DataLayer{Name: "tenant", Data: map[string]interface{}{"currency": "EUR"}},
+		&GenerateRequest{Data: map[string]interface{}{"n": 1}, Options: &GenerateOptions{Filename: "invoice", Store: true}},
+		&GenerateRequest{Layers: []DataLayer{{Name: "defaults"}}},
+		&PrintRequest{Source: SourceFromDocument("doc_1")},
+	}
+	for _, v := range requestSchemaTypes {
+		// Zero values, as encoded by encoding/json.
+		values = append(values, reflect.New(reflect.TypeOf(v).Elem()).Interface())
+	}
+	createdAt := time.Date(2024, 5, 1, 12, 0, 0, 0, time.UTC)
+	values = append(values, &DocumentMetadata{Filename: "a.pdf", CreatedAt: &createdAt})
+
+	for _, v := range values {
+		data, err := json.Marshal(v)
+		if err != nil {
+			t.Fatalf("Marshal(%T): %v", v, err)
+		}
+		if err := ValidateJSON(JSONSchema(v), data); err != nil {
+			t.Errorf("%T %s: %v", v, data, err)
+		}
+	}
+}
+
+func TestValidateJSONRejectsWrongTypes(t *testing.T) {
+	schema := JSONSchema(&DataLayer{})
+	for _, body := range []string{`{"name":"x","data":[]}`, `{"name":1,"data":null}`, `{"data":{}}`} {
+		if err := ValidateJSON(schema, []byte(body)); err == nil {
+			t.Errorf("ValidateJSON(%s) = nil, want error", body)
+		}
+	}
+}
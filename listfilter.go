@@ -0,0 +1,127 @@
+package documentstack
+
+import (
+	"net/url"
+	"time"
+)
+
+// SortOrder is the order of list results.
+type SortOrder string
+
+// Sort orders.
+const (
+	SortCreatedAsc  SortOrder = "createdAt"
+	SortCreatedDesc SortOrder = "-createdAt"
+	SortUpdatedAsc  SortOrder = "updatedAt"
+	SortUpdatedDesc SortOrder = "-updatedAt"
+	SortNameAsc     SortOrder = "name"
+	SortNameDesc    SortOrder = "-name"
+)
+
+// ListStatus is a resource status that list results can be filtered by,
+// such as PrintStatus or EnvelopeStatus.
+type ListStatus interface {
+	listStatus() string
+}
+
+func (s PrintStatus) listStatus() string         { return string(s) }
+func (s EnvelopeStatus) listStatus() string      { return string(s) }
+func (s EncryptionKeyStatus) listStatus() string { return string(s) }
+func (s JobStatus) listStatus() string           { return string(s) }
+func (s ArchiveStatus) listStatus() string       { return string(s) }
+
+// ListFilter filters and sorts the results of list endpoints. Build one with
+// Filter, or start from the zero value, and set it as ListOptions.Filter or
+// ListAllOptions.Filter. Filters an endpoint does not support are rejected by
+// the API.
+//
+// Example:
+//
+//	jobs, err := client.Print.List(ctx, &documentstack.ListOptions{
+//		Filter: documentstack.Filter().
+//			Status(documentstack.PrintMailed, documentstack.PrintDelivered).
+//			CreatedAfter(time.Now().AddDate(0, 0, -7)).
+//			SortBy(documentstack.SortCreatedDesc),
+//	})
+type ListFilter struct {
+	values url.Values
+}
+
+// Filter returns an empty ListFilter.
+func Filter() *ListFilter {
+	return &ListFilter{values: url.Values{}}
+}
+
+// Status limits results to resources with any of the given statuses.
+func (f *ListFilter) Status(statuses ...ListStatus) *ListFilter {
+	for _, status := range statuses {
+		f.add("status", status.listStatus())
+	}
+	return f
+}
+
+// CreatedAfter limits results to resources created at or after t.
+func (f *ListFilter) CreatedAfter(t time.Time) *ListFilter {
+	f.set("createdAfter", t.UTC().Format(time.RFC3339))
+	return f
+}
+
+// CreatedBefore limits results to resources created before t.
+func (f *ListFilter) CreatedBefore(t time.Time) *ListFilter {
+	f.set("createdBefore", t.UTC().Format(time.RFC3339))
+	return f
+}
+
+// UpdatedAfter limits results to resources updated at or after t.
+func (f *ListFilter) UpdatedAfter(t time.Time) *ListFilter {
+	f.set("updatedAfter", t.UTC().Format(time.RFC3339))
+	return f
+}
+
+// Name limits results to resources whose name contains name, ignoring case.
+func (f *ListFilter) Name(name string) *ListFilter {
+	f.set("name", name)
+	return f
+}
+
+// Tag limits results to resources with the tag key set to value. Repeated
+// calls require all tags.
+func (f *ListFilter) Tag(key, value string) *ListFilter {
+	f.add("tag", key+":"+value)
+	return f
+}
+
+// SortBy sets the order of results.
+// Default: SortCreatedDesc
+func (f *ListFilter) SortBy(order SortOrder) *ListFilter {
+	f.set("sort", string(order))
+	return f
+}
+
+// set and add update the filter's values, allocating them on first use so
+// that the zero ListFilter is usable.
+func (f *ListFilter) set(key, value string) {
+	if f.values == nil {
+		f.values = url.Values{}
+	}
+	f.values.Set(key, value)
+}
+
+func (f *ListFilter) add(key, value string) {
+	if f.values == nil {
+		f.values = url.Values{}
+	}
+	f.values.Add(key, value)
+}
+
+// encode adds the filter to values.
+func (f *ListFilter) encode(values url.Values) {
+	if f == nil {
+		return
+	}
+	for key, vs := range f.values {
+		for _, v := range vs {
+			values.Add(key, v)
+		}
+	}
+}
@@ -0,0 +1,130 @@
+package documentstack
+
+import (
+	"reflect"
+	"strings"
+)
+
+// DataLayer is a named source of template data, e.g. tenant defaults,
+// document data or runtime overrides.
+type DataLayer struct {
+	// Name identifies the layer in conflicts, e.g. "tenant".
+	Name string `json:"name"`
+
+	Data map[string]interface{} `json:"data"`
+}
+
+// MergeConflict reports a value that a later layer replaced with a
+// different one.
+type MergeConflict struct {
+	// Path is the dot-separated data path, e.g. "customer.address".
+	Path string
+
+	// Layer is the name of the layer whose value was used, and Overridden the
+	// name of the layer whose value was replaced.
+	Layer      string
+	Overridden string
+
+	// Value is the value used, and OverriddenValue the replaced value.
+	Value           interface{}
+	OverriddenValue interface{}
+}
+
+// MergeData merges layers in order, later layers taking precedence, and
+// reports the values that were replaced. Nested objects are merged key by
+// key; any other value, including arrays, is replaced as a whole. Setting
+// equal values in several layers is not a conflict. The layers are not
+// modified.
+//
+// Layers can also be merged by the API with GenerateRequest.Layers.
+//
+// Example:
+//
+//	data, conflicts := documentstack.MergeData(
+//		documentstack.DataLayer{Name: "tenant", Data: tenantDefaults},
+//		documentstack.DataLayer{Name: "document", Data: invoice},
+//		documentstack.DataLayer{Name: "overrides", Data: overrides},
+//	)
+//	for _, conflict := range conflicts {
+//		log.Printf("%s: %s overrides %s", conflict.Path, conflict.Layer, conflict.Overridden)
+//	}
+func MergeData(layers ...DataLayer) (map[string]interface{}, []MergeConflict) {
+	merged := map[string]interface{}{}
+	owners := map[string]string{}
+	var conflicts []MergeConflict
+
+	for _, layer := range layers {
+		mergeLayer(merged, layer.Data, "", layer.Name, owners, &conflicts)
+	}
+
+	return merged, conflicts
+}
+
+// mergeLayer merges src into dst. owners maps the paths of merged values to
+// the name of the layer that set them.
+func mergeLayer(dst, src map[string]interface{}, prefix, layer string, owners map[string]string, conflicts *[]MergeConflict) {
+	for _, key := range sortedKeys(src) {
+		value := src[key]
+		path := key
+		if prefix != "" {
+			path = prefix + "." + key
+		}
+
+		existing, exists := dst[key]
+		existingObject, existingIsObject := existing.(map[string]interface{})
+		object, isObject := value.(map[string]interface{})
+
+		switch {
+		case isObject && (!exists || existingIsObject):
+			if !exists {
+				existingObject = map[string]interface{}{}
+				dst[key] = existingObject
+			}
+			mergeLayer(existingObject, object, path, layer, owners, conflicts)
+			continue
+		case exists && !reflect.DeepEqual(existing, value):
+			*conflicts = append(*conflicts, MergeConflict{
+				Path:            path,
+				Layer:           layer,
+				Overridden:      layerOwning(owners, path),
+				Value:           value,
+				OverriddenValue: existing,
+			})
+		}
+
+		if isObject {
+			value = copyData(object)
+		}
+		dst[key] = value
+		for owned := range owners {
+			if strings.HasPrefix(owned, path+".") {
+				delete(owners, owned)
+			}
+		}
+		owners[path] = layer
+	}
+}
+
+// layerOwning returns the layer that set path or an object containing it, or,
+// if path is an object, the layers that set values within it.
+func layerOwning(owners map[string]string, path string) string {
+	for owned := path; ; {
+		if layer, ok := owners[owned]; ok {
+			return layer
+		}
+		i := strings.LastIndex(owned, ".")
+		if i < 0 {
+			break
+		}
+		owned = owned[:i]
+	}
+
+	seen := map[string]bool{}
+	for owned, layer := range owners {
+		if strings.HasPrefix(owned, path+".") {
+			seen[layer] = true
+		}
+	}
+
+	return strings.Join(sortedKeys(seen), ", ")
+}
@@ -0,0 +1,93 @@
+package documentstack
+
+import (
+	"sort"
+	"strings"
+)
+
+// MigrationMap maps old template data paths to their new paths, e.g.
+// "customer.zip" to "customer.address.postalCode", so that call sites using
+// the old names keep working while a template schema change rolls out.
+// Paths are dot-separated keys into nested objects.
+//
+// A migration map can be applied client-side, with Config.Migrations or
+// Apply, or server-side, with GenerateRequest.Migrations.
+type MigrationMap map[string]string
+
+// Apply returns a copy of data with each old path moved to its new path.
+// A value already present at the new path takes precedence, and the old path
+// is dropped. data is not modified.
+func (m MigrationMap) Apply(data map[string]interface{}) map[string]interface{} {
+	if data == nil {
+		return nil
+	}
+
+	migrated := copyData(data)
+
+	oldPaths := make([]string, 0, len(m))
+	for oldPath := range m {
+		oldPaths = append(oldPaths, oldPath)
+	}
+	sort.Strings(oldPaths)
+
+	for _, oldPath := range oldPaths {
+		value, ok := removePath(migrated, strings.Split(oldPath, "."))
+		if !ok {
+			continue
+		}
+		setPath(migrated, strings.Split(m[oldPath], "."), value)
+	}
+
+	return migrated
+}
+
+// copyData deep-copies nested data objects. Other values are shared.
+func copyData(data map[string]interface{}) map[string]interface{} {
+	copied := make(map[string]interface{}, len(data))
+	for key, value := range data {
+		if nested, ok := value.(map[string]interface{}); ok {
+			value = copyData(nested)
+		}
+		copied[key] = value
+	}
+	return copied
+}
+
+// removePath deletes the value at path and reports whether it existed.
+func removePath(data map[string]interface{}, path []string) (interface{}, bool) {
+	for _, key := range path[:len(path)-1] {
+		nested, ok := data[key].(map[string]interface{})
+		if !ok {
+			return nil, false
+		}
+		data = nested
+	}
+
+	last := path[len(path)-1]
+	value, ok := data[last]
+	if ok {
+		delete(data, last)
+	}
+	return value, ok
+}
+
+// setPath sets the value at path, creating intermediate objects, unless a
+// value is already present there.
+func setPath(data map[string]interface{}, path []string, value interface{}) {
+	for _, key := range path[:len(path)-1] {
+		nested, ok := data[key].(map[string]interface{})
+		if !ok {
+			if _, exists := data[key]; exists {
+				return
+			}
+			nested = map[string]interface{}{}
+			data[key] = nested
+		}
+		data = nested
+	}
+
+	last := path[len(path)-1]
+	if _, exists := data[last]; !exists {
+		data[last] = value
+	}
+}
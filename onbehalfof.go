@@ -0,0 +1,26 @@
+package documentstack
+
+import "context"
+
+const onBehalfOfHeader = "X-On-Behalf-Of"
+
+type onBehalfOfKey struct{}
+
+// WithOnBehalfOf returns a context that attributes calls made with it to one
+// of your customers or end users. The ID is sent in the X-On-Behalf-Of header
+// and echoed in audit logs, usage reports (UsageFilter.OnBehalfOf), job
+// records and webhook payloads, for per-customer billing reconciliation.
+//
+// Example:
+//
+//	ctx = documentstack.WithOnBehalfOf(ctx, customer.ID)
+//	invoice, err := client.Generate(ctx, "invoice", request)
+func WithOnBehalfOf(ctx context.Context, id string) context.Context {
+	return context.WithValue(ctx, onBehalfOfKey{}, id)
+}
+
+// onBehalfOf returns the ID set with WithOnBehalfOf, if any.
+func onBehalfOf(ctx context.Context) string {
+	id, _ := ctx.Value(onBehalfOfKey{}).(string)
+	return id
+}
@@ -0,0 +1,75 @@
+package documentstack
+
+import (
+	"context"
+	"time"
+)
+
+// ErrTooManyItems is returned by ListAll methods, together with the first
+// ListAllOptions.MaxItems items, when more items exist.
+var ErrTooManyItems = &DocumentStackError{Message: "list has more items than ListAllOptions.MaxItems"}
+
+// ListAllOptions bounds a ListAll call, which fetches every page of a list.
+type ListAllOptions struct {
+	// PageSize is the number of items requested per page.
+	// Default: the API's page size
+	PageSize int
+
+	// MaxItems is the maximum number of items returned. If the list has more,
+	// ListAll returns the first MaxItems items with ErrTooManyItems.
+	// Default: 10000
+	MaxItems int
+
+	// PageDelay is waited between page requests, to stay below rate limits.
+	// Default: 0
+	PageDelay time.Duration
+
+	// Filter filters and sorts the results. See Filter.
+	Filter *ListFilter
+}
+
+const defaultListAllMaxItems = 10000
+
+// listAll calls fetch with successive cursors until the last page, subject
+// to opts, and returns all items.
+func listAll[T any](ctx context.Context, opts *ListAllOptions, fetch func(opts *ListOptions) ([]T, string, error)) ([]T, error) {
+	maxItems := defaultListAllMaxItems
+	var pageDelay time.Duration
+	page := &ListOptions{}
+	if opts != nil {
+		if opts.MaxItems > 0 {
+			maxItems = opts.MaxItems
+		}
+		pageDelay = opts.PageDelay
+		page.Limit = opts.PageSize
+		page.Filter = opts.Filter
+	}
+
+	var all []T
+	for {
+		items, next, err := fetch(page)
+		if err != nil {
+			return nil, err
+		}
+
+		all = append(all, items...)
+		if len(all) > maxItems || (len(all) == maxItems && next != "") {
+			return all[:maxItems], ErrTooManyItems
+		}
+
+		if next == "" {
+			return all, nil
+		}
+		page.Cursor = next
+
+		if pageDelay > 0 {
+			timer := time.NewTimer(pageDelay)
+			select {
+			case <-ctx.Done():
+				timer.Stop()
+				return nil, ctx.Err()
+			case <-timer.C:
+			}
+		}
+	}
+}
@@ -0,0 +1,119 @@
+package documentstack
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"time"
+)
+
+// PartialsService manages shared partials such as headers, footers and legal
+// blocks. Templates include a partial by its ID, so updating a partial changes
+// every template that uses it.
+type PartialsService struct {
+	client *Client
+}
+
+// Partial is a shared template fragment.
+type Partial struct {
+	// ID is the unique partial identifier, used to include it from templates.
+	ID string `json:"id"`
+
+	// Name is the human-readable partial name.
+	Name string `json:"name"`
+
+	// Description is an optional description of the partial.
+	Description string `json:"description,omitempty"`
+
+	// Content is the partial source.
+	Content string `json:"content"`
+
+	// CreatedAt is the time the partial was created. Set by the API.
+	CreatedAt time.Time `json:"createdAt"`
+
+	// UpdatedAt is the time the partial was last updated. Set by the API.
+	UpdatedAt time.Time `json:"updatedAt"`
+}
+
+// PartialList is a page of partials.
+type PartialList struct {
+	// Partials are the partials on this page. Content is not included.
+	Partials   []*Partial `json:"partials"`
+	NextCursor string     `json:"nextCursor,omitempty"`
+}
+
+// List returns a page of partials.
+func (s *PartialsService) List(ctx context.Context, opts *ListOptions) (*PartialList, error) {
+	var result PartialList
+	if err := s.client.doJSON(ctx, "GET", listPath("/api/v1/partials", opts), nil, &result); err != nil {
+		return nil, err
+	}
+
+	return &result, nil
+}
+
+// ListAll returns all partials, without content, fetching every page. See ListAllOptions.
+func (s *PartialsService) ListAll(ctx context.Context, opts *ListAllOptions) ([]*Partial, error) {
+	return listAll(ctx, opts, func(page *ListOptions) ([]*Partial, string, error) {
+		result, err := s.List(ctx, page)
+		if err != nil {
+			return nil, "", err
+		}
+		return result.Partials, result.NextCursor, nil
+	})
+}
+
+// Get retrieves a partial including its content.
+func (s *PartialsService) Get(ctx context.Context, partialID string) (*Partial, error) {
+	if partialID == "" {
+		return nil, NewValidationError("Partial ID is required", nil)
+	}
+
+	var result Partial
+	endpoint := fmt.Sprintf("/api/v1/partials/%s", url.PathEscape(partialID))
+	if err := s.client.doJSON(ctx, "GET", endpoint, nil, &result); err != nil {
+		return nil, err
+	}
+
+	return &result, nil
+}
+
+// Push creates the partial or replaces its content if a partial with the same ID exists.
+func (s *PartialsService) Push(ctx context.Context, partial *Partial) (*Partial, error) {
+	if partial == nil || partial.ID == "" {
+		return nil, NewValidationError("Partial ID is required", nil)
+	}
+
+	var result Partial
+	endpoint := fmt.Sprintf("/api/v1/partials/%s", url.PathEscape(partial.ID))
+	if err := s.client.doJSON(ctx, "PUT", endpoint, partial, &result); err != nil {
+		return nil, err
+	}
+
+	return &result, nil
+}
+
+// Delete deletes a partial. The API rejects deleting a partial that templates still use.
+func (s *PartialsService) Delete(ctx context.Context, partialID string) error {
+	if partialID == "" {
+		return NewValidationError("Partial ID is required", nil)
+	}
+
+	endpoint := fmt.Sprintf("/api/v1/partials/%s", url.PathEscape(partialID))
+	return s.client.doJSON(ctx, "DELETE", endpoint, nil, nil)
+}
+
+// Usages returns a page of the templates that include the partial.
+func (s *PartialsService) Usages(ctx context.Context, partialID string, opts *ListOptions) (*TemplateList, error) {
+	if partialID == "" {
+		return nil, NewValidationError("Partial ID is required", nil)
+	}
+
+	var result TemplateList
+	endpoint := fmt.Sprintf("/api/v1/partials/%s/usages", url.PathEscape(partialID))
+	if err := s.client.doJSON(ctx, "GET", listPath(endpoint, opts), nil, &result); err != nil {
+		return nil, err
+	}
+
+	return &result, nil
+}
@@ -0,0 +1,70 @@
+package documentstack
+
+import "context"
+
+// PIIEntity is a piece of personally identifiable information found in a document.
+type PIIEntity struct {
+	// Type is the entity type, e.g. "ssn", "iban", "email", "phone" or "credit_card".
+	Type string `json:"type"`
+
+	// Text is the matched text, partially masked by the API.
+	Text string `json:"text"`
+
+	// Page is the 1-based page number.
+	Page int `json:"page"`
+
+	// Box is the location of the match on the page.
+	Box Rect `json:"box"`
+
+	// Confidence is the detection confidence between 0 and 1.
+	Confidence float64 `json:"confidence"`
+}
+
+// Rect is a rectangle on a page in PDF points, measured from the top-left corner.
+type Rect struct {
+	X      float64 `json:"x"`
+	Y      float64 `json:"y"`
+	Width  float64 `json:"width"`
+	Height float64 `json:"height"`
+}
+
+// PIIScanOptions controls a PII scan.
+type PIIScanOptions struct {
+	// Types limits the scan to these entity types. Default: all supported types.
+	Types []string `json:"types,omitempty"`
+
+	// MinConfidence drops entities below this confidence.
+	MinConfidence float64 `json:"minConfidence,omitempty"`
+}
+
+// PIIScanResult lists the PII entities found in a document.
+type PIIScanResult struct {
+	Entities []PIIEntity `json:"entities"`
+}
+
+// ScanPII scans a document for personally identifiable information such as
+// SSNs, IBANs and email addresses. opts may be nil.
+//
+// Example:
+//
+//	scan, err := client.ScanPII(ctx, documentstack.SourceFromBytes(result.PDF), nil)
+//	if err == nil && len(scan.Entities) > 0 {
+//		// block outbound delivery
+//	}
+func (c *Client) ScanPII(ctx context.Context, source *Source, opts *PIIScanOptions) (*PIIScanResult, error) {
+	if err := source.validate(); err != nil {
+		return nil, err
+	}
+
+	body := map[string]interface{}{
+		"source":  source,
+		"options": opts,
+	}
+
+	var result PIIScanResult
+	if err := c.queryJSON(ctx, "/api/v1/pii/scan", body, &result); err != nil {
+		return nil, err
+	}
+
+	return &result, nil
+}
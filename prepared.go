@@ -0,0 +1,202 @@
+package documentstack
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"log"
+	"net/url"
+	"sync"
+)
+
+// maxPooledBufferSize is the largest request buffer returned to the pool, so
+// that an occasional huge payload does not stay allocated.
+const maxPooledBufferSize = 1 << 20
+
+// encodeBuffer is a pooled request body buffer with an encoder writing to it.
+type encodeBuffer struct {
+	buf bytes.Buffer
+	enc *json.Encoder
+}
+
+var encodeBufferPool = sync.Pool{
+	New: func() interface{} {
+		b := &encodeBuffer{}
+		b.enc = json.NewEncoder(&b.buf)
+		return b
+	},
+}
+
+func getEncodeBuffer() *encodeBuffer {
+	b := encodeBufferPool.Get().(*encodeBuffer)
+	b.buf.Reset()
+	return b
+}
+
+func putEncodeBuffer(b *encodeBuffer) {
+	if b.buf.Cap() > maxPooledBufferSize {
+		return
+	}
+	encodeBufferPool.Put(b)
+}
+
+// PreparedRequest generates documents from one template with fixed options,
+// for hot paths that generate many documents. The endpoint and options are
+// encoded once by Client.Prepare, and request bodies are encoded into pooled
+// buffers, so each generation only encodes its data.
+//
+// Config.Deduplicate does not apply to prepared requests. A PreparedRequest
+// is safe for concurrent use.
+//
+// Example:
+//
+//	invoice, err := client.Prepare("invoice", &documentstack.GenerateRequest{
+//		Options: &documentstack.GenerateOptions{Priority: documentstack.PriorityHigh},
+//	})
+//	if err != nil {
+//		return err
+//	}
+//	for _, data := range invoices {
+//		response, err := invoice.Generate(ctx, data)
+//		...
+//	}
+type PreparedRequest struct {
+	client     *Client
+	templateID string
+	path       string
+	template   GenerateRequest
+
+	// encoded is the encoded request without data, and prefix the encoded
+	// request up to the data value.
+	encoded []byte
+	prefix  []byte
+}
+
+// Prepare validates and encodes everything but the data of request, which
+// may be nil, for repeated generations from templateID. request.Data is ignored.
+func (c *Client) Prepare(templateID string, request *GenerateRequest) (*PreparedRequest, error) {
+	if templateID == "" {
+		return nil, NewValidationError("Template ID is required", nil)
+	}
+
+	var template GenerateRequest
+	if request != nil {
+		template = *request
+		template.Data = nil
+	}
+	if err := template.Options.validate(); err != nil {
+		return nil, err
+	}
+
+	encoded, err := json.Marshal(&template)
+	if err != nil {
+		return nil, &NetworkError{Message: "failed to marshal request body", Cause: err}
+	}
+
+	// Open the encoded object for the data field: `{...}` becomes `{...,"data":`.
+	prefix := append([]byte{}, encoded[:len(encoded)-1]...)
+	if len(prefix) > 1 {
+		prefix = append(prefix, ',')
+	}
+	prefix = append(prefix, `"data":`...)
+
+	return &PreparedRequest{
+		client:     c,
+		templateID: templateID,
+		path:       fmt.Sprintf("/api/v1/generate/%s", url.PathEscape(templateID)),
+		template:   template,
+		encoded:    encoded,
+		prefix:     prefix,
+	}, nil
+}
+
+// TemplateID returns the template the request generates from.
+func (p *PreparedRequest) TemplateID() string {
+	return p.templateID
+}
+
+// Generate generates a PDF from data, like Client.Generate.
+func (p *PreparedRequest) Generate(ctx context.Context, data map[string]interface{}) (*GenerateResponse, error) {
+	stream, err := p.GenerateStream(ctx, data)
+	if err == nil {
+		var response *GenerateResponse
+		if response, err = readStream(stream); err == nil {
+			return response, nil
+		}
+	}
+
+	request := p.template
+	request.Data = data
+	return p.client.fallback(ctx, p.templateID, &request, err)
+}
+
+// GenerateStream generates a PDF from data and returns the response body
+// unread, like Client.GenerateStream. The caller must close StreamResponse.Body.
+func (p *PreparedRequest) GenerateStream(ctx context.Context, data map[string]interface{}) (*StreamResponse, error) {
+	c := p.client
+	ctx = withOperation(ctx, operationGenerate)
+
+	if data != nil {
+		prepared, err := c.prepareData(p.templateID, data)
+		if err != nil {
+			return nil, err
+		}
+		data = prepared
+	}
+
+	b := getEncodeBuffer()
+	if len(data) == 0 {
+		// Omitted, like the data field of GenerateRequest.
+		b.buf.Write(p.encoded)
+	} else {
+		b.buf.Write(p.prefix)
+		if err := b.enc.Encode(data); err != nil {
+			putEncodeBuffer(b)
+			return nil, &NetworkError{Message: "failed to marshal request body", Cause: err}
+		}
+		b.buf.Truncate(b.buf.Len() - 1) // Encode's trailing newline
+		b.buf.WriteByte('}')
+	}
+
+	if c.config.Debug {
+		log.Printf("[DocumentStack] Body: %s\n", redactJSON(b.buf.Bytes(), defaultScrubbedFields))
+	}
+
+	req, err := c.newRequest(ctx, "POST", p.path, bytes.NewReader(b.buf.Bytes()), "application/json")
+	if err != nil {
+		putEncodeBuffer(b)
+		return nil, err
+	}
+
+	resp, err := c.sendRetryable(ctx, req)
+	if err != nil {
+		putEncodeBuffer(b)
+		return nil, err
+	}
+
+	stream, err := c.streamResponse(resp)
+	if err != nil {
+		putEncodeBuffer(b)
+		return nil, err
+	}
+
+	// The transport may read the request body until the response is done,
+	// so the buffer is only reused once the response body is closed.
+	stream.Body = &releaseOnClose{ReadCloser: stream.Body, release: func() { putEncodeBuffer(b) }}
+	return stream, nil
+}
+
+// releaseOnClose calls release once, when the body is first closed.
+type releaseOnClose struct {
+	io.ReadCloser
+	release func()
+	once    sync.Once
+}
+
+func (r *releaseOnClose) Close() error {
+	err := r.ReadCloser.Close()
+	r.once.Do(r.release)
+	return err
+}
@@ -0,0 +1,102 @@
+package documentstack
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"reflect"
+	"testing"
+)
+
+func TestPreparedRequestBodyMatchesGenerate(t *testing.T) {
+	var bodies [][]byte
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		bodies = append(bodies, body)
+		writePDF(w)
+	})
+
+	options := &GenerateOptions{Filename: "invoice", Priority: PriorityHigh}
+	prepared, err := client.Prepare("invoice", &GenerateRequest{Options: options})
+	if err != nil {
+		t.Fatalf("Prepare: %v", err)
+	}
+
+	for _, data := range []map[string]interface{}{nil, {}, {"total": 42.5, "items": []interface{}{"a", "b"}}} {
+		bodies = nil
+		if _, err := client.Generate(context.Background(), "invoice", &GenerateRequest{Data: data, Options: options}); err != nil {
+			t.Fatalf("Generate: %v", err)
+		}
+		if _, err := prepared.Generate(context.Background(), data); err != nil {
+			t.Fatalf("PreparedRequest.Generate: %v", err)
+		}
+
+		var want, got map[string]interface{}
+		if err := json.Unmarshal(bodies[0], &want); err != nil {
+			t.Fatalf("Generate body %s: %v", bodies[0], err)
+		}
+		if err := json.Unmarshal(bodies[1], &got); err != nil {
+			t.Fatalf("prepared body %s: %v", bodies[1], err)
+		}
+		if !reflect.DeepEqual(got, want) {
+			t.Errorf("data %v: prepared body %s, want %s", data, bodies[1], bodies[0])
+		}
+	}
+}
+
+// benchmarkData is a typical invoice payload.
+var benchmarkData = map[string]interface{}{
+	"number":   "INV-2024-0042",
+	"customer": map[string]interface{}{"name": "Jane Doe", "email": "jane@example.com"},
+	"items": []interface{}{
+		map[string]interface{}{"description": "Consulting", "quantity": 10, "price": 150.0},
+		map[string]interface{}{"description": "Support", "quantity": 1, "price": 499.0},
+	},
+	"total": 1999.0,
+}
+
+var benchmarkOptions = &GenerateOptions{
+	Filename: "invoice",
+	Priority: PriorityHigh,
+	Tags:     map[string]string{"product": "billing"},
+}
+
+func newBenchmarkClient(b *testing.B) *Client {
+	return newTestClient(b, func(w http.ResponseWriter, r *http.Request) {
+		io.Copy(io.Discard, r.Body)
+		writePDF(w)
+	})
+}
+
+func BenchmarkGenerate(b *testing.B) {
+	client := newBenchmarkClient(b)
+	ctx := context.Background()
+
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		request := &GenerateRequest{Data: benchmarkData, Options: benchmarkOptions}
+		if _, err := client.Generate(ctx, "invoice", request); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+func BenchmarkPreparedGenerate(b *testing.B) {
+	client := newBenchmarkClient(b)
+	ctx := context.Background()
+
+	prepared, err := client.Prepare("invoice", &GenerateRequest{Options: benchmarkOptions})
+	if err != nil {
+		b.Fatal(err)
+	}
+
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		if _, err := prepared.Generate(ctx, benchmarkData); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
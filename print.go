@@ -0,0 +1,189 @@
+package documentstack
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"time"
+)
+
+// PostageClass is the mail service used for a print job.
+type PostageClass string
+
+// Postage classes.
+const (
+	PostageStandard  PostageClass = "standard"
+	PostageFirst     PostageClass = "first_class"
+	PostageCertified PostageClass = "certified"
+)
+
+// PrintStatus is the state of a print job.
+type PrintStatus string
+
+// Print job statuses.
+const (
+	PrintQueued    PrintStatus = "queued"
+	PrintPrinting  PrintStatus = "printing"
+	PrintMailed    PrintStatus = "mailed"
+	PrintDelivered PrintStatus = "delivered"
+	PrintReturned  PrintStatus = "returned"
+	PrintCancelled PrintStatus = "cancelled"
+	PrintFailed    PrintStatus = "failed"
+)
+
+// PrintService submits documents to the print-and-mail partner and tracks them.
+type PrintService struct {
+	client *Client
+}
+
+// PostalAddress is a mailing address.
+type PostalAddress struct {
+	Name       string   `json:"name"`
+	Company    string   `json:"company,omitempty"`
+	Lines      []string `json:"lines"`
+	City       string   `json:"city"`
+	State      string   `json:"state,omitempty"`
+	PostalCode string   `json:"postalCode"`
+	Country    string   `json:"country"` // ISO 3166-1 alpha-2
+}
+
+// PrintRequest describes a document to print and mail.
+type PrintRequest struct {
+	// Source is the document to print.
+	Source *Source `json:"source"`
+
+	// To is the recipient address.
+	To *PostalAddress `json:"to"`
+
+	// From is the return address. Default: the workspace address.
+	From *PostalAddress `json:"from,omitempty"`
+
+	// Postage is the mail service.
+	// Default: PostageStandard
+	Postage PostageClass `json:"postage,omitempty"`
+
+	// Duplex prints on both sides of the paper.
+	Duplex bool `json:"duplex,omitempty"`
+
+	// Color prints in color instead of black and white.
+	Color bool `json:"color,omitempty"`
+}
+
+// PrintJob is a submitted print job.
+type PrintJob struct {
+	ID      string       `json:"id"`
+	Status  PrintStatus  `json:"status"`
+	Postage PostageClass `json:"postage"`
+
+	// PageCount is the number of printed pages.
+	PageCount int `json:"pageCount"`
+
+	// TrackingNumber is the carrier tracking number, once mailed.
+	TrackingNumber string `json:"trackingNumber,omitempty"`
+
+	// ExpectedDelivery is the estimated delivery date, once mailed.
+	ExpectedDelivery *time.Time `json:"expectedDelivery,omitempty"`
+
+	// Error describes why the job failed, if it did.
+	Error string `json:"error,omitempty"`
+
+	CreatedAt time.Time `json:"createdAt"`
+	UpdatedAt time.Time `json:"updatedAt"`
+}
+
+// PrintJobList is a page of print jobs.
+type PrintJobList struct {
+	Jobs       []*PrintJob `json:"jobs"`
+	NextCursor string      `json:"nextCursor,omitempty"`
+}
+
+// Submit sends a document to be printed and mailed.
+//
+// Example:
+//
+//	job, err := client.Print.Submit(ctx, &documentstack.PrintRequest{
+//		Source: documentstack.SourceFromDocument(documentID),
+//		To: &documentstack.PostalAddress{
+//			Name:       "Jane Doe",
+//			Lines:      []string{"1 Main St"},
+//			City:       "Springfield",
+//			State:      "IL",
+//			PostalCode: "62701",
+//			Country:    "US",
+//		},
+//		Postage: documentstack.PostageFirst,
+//		Duplex:  true,
+//	})
+func (s *PrintService) Submit(ctx context.Context, request *PrintRequest) (*PrintJob, error) {
+	if request == nil {
+		return nil, NewValidationError("Print request is required", nil)
+	}
+	if err := request.Source.validate(); err != nil {
+		return nil, err
+	}
+	if request.To == nil {
+		return nil, NewValidationError("Recipient address is required", nil)
+	}
+	if err := s.client.requireFeature(ctx, FeaturePrint); err != nil {
+		return nil, err
+	}
+
+	var result PrintJob
+	if err := s.client.doJSON(ctx, "POST", "/api/v1/print/jobs", request, &result); err != nil {
+		return nil, err
+	}
+
+	return &result, nil
+}
+
+// Get retrieves a print job, including its current status.
+func (s *PrintService) Get(ctx context.Context, jobID string) (*PrintJob, error) {
+	if jobID == "" {
+		return nil, NewValidationError("Print job ID is required", nil)
+	}
+
+	var result PrintJob
+	endpoint := fmt.Sprintf("/api/v1/print/jobs/%s", url.PathEscape(jobID))
+	if err := s.client.doJSON(ctx, "GET", endpoint, nil, &result); err != nil {
+		return nil, err
+	}
+
+	return &result, nil
+}
+
+// List returns a page of print jobs, most recent first.
+func (s *PrintService) List(ctx context.Context, opts *ListOptions) (*PrintJobList, error) {
+	var result PrintJobList
+	if err := s.client.doJSON(ctx, "GET", listPath("/api/v1/print/jobs", opts), nil, &result); err != nil {
+		return nil, err
+	}
+
+	return &result, nil
+}
+
+// ListAll returns all print jobs, most recent first, fetching every page. See
+// ListAllOptions.
+func (s *PrintService) ListAll(ctx context.Context, opts *ListAllOptions) ([]*PrintJob, error) {
+	return listAll(ctx, opts, func(page *ListOptions) ([]*PrintJob, string, error) {
+		result, err := s.List(ctx, page)
+		if err != nil {
+			return nil, "", err
+		}
+		return result.Jobs, result.NextCursor, nil
+	})
+}
+
+// Cancel cancels a print job that has not started printing.
+func (s *PrintService) Cancel(ctx context.Context, jobID string) (*PrintJob, error) {
+	if jobID == "" {
+		return nil, NewValidationError("Print job ID is required", nil)
+	}
+
+	var result PrintJob
+	endpoint := fmt.Sprintf("/api/v1/print/jobs/%s/cancel", url.PathEscape(jobID))
+	if err := s.client.doJSON(ctx, "POST", endpoint, nil, &result); err != nil {
+		return nil, err
+	}
+
+	return &result, nil
+}
@@ -0,0 +1,304 @@
+package documentstack
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"reflect"
+	"sort"
+	"strings"
+)
+
+// ResourceKind is the kind of resource managed by Provision.
+type ResourceKind string
+
+// Resource kinds.
+const (
+	ResourceTemplate    ResourceKind = "template"
+	ResourceDestination ResourceKind = "destination"
+	ResourceWebhook     ResourceKind = "webhook"
+	ResourceAPIKey      ResourceKind = "api_key"
+)
+
+// ProvisionConfig is the declarative description of a workspace.
+//
+// Templates are identified by ID, destinations and API keys by name, and
+// webhooks by URL.
+type ProvisionConfig struct {
+	Templates    []*Template    `json:"templates,omitempty"`
+	Destinations []*Destination `json:"destinations,omitempty"`
+	Webhooks     []*Webhook     `json:"webhooks,omitempty"`
+	APIKeys      []*APIKey      `json:"apiKeys,omitempty"`
+}
+
+// ReadProvisionConfig decodes a JSON ProvisionConfig from r.
+func ReadProvisionConfig(r io.Reader) (*ProvisionConfig, error) {
+	var config ProvisionConfig
+	if err := json.NewDecoder(r).Decode(&config); err != nil {
+		return nil, &DocumentStackError{Message: fmt.Sprintf("failed to decode provision config: %v", err)}
+	}
+	return &config, nil
+}
+
+// ProvisionChange is a single planned change.
+type ProvisionChange struct {
+	Action SyncAction
+	Kind   ResourceKind
+	Name   string
+}
+
+// ProvisionPlan is the set of changes needed to converge the workspace with a
+// ProvisionConfig.
+type ProvisionPlan struct {
+	// Changes are the planned changes, in the order they are applied.
+	Changes []ProvisionChange
+
+	// CreatedAPIKeys are the API keys created while applying the plan,
+	// including their secrets, which cannot be retrieved again.
+	CreatedAPIKeys []*APIKey
+}
+
+// String formats the plan as one line per change followed by a summary.
+func (p *ProvisionPlan) String() string {
+	var b strings.Builder
+	counts := make(map[SyncAction]int)
+
+	for _, change := range p.Changes {
+		symbol := "~"
+		switch change.Action {
+		case SyncCreate:
+			symbol = "+"
+		case SyncDelete:
+			symbol = "-"
+		}
+		fmt.Fprintf(&b, "%s %s %s\n", symbol, change.Kind, change.Name)
+		counts[change.Action]++
+	}
+
+	fmt.Fprintf(&b, "Plan: %d to create, %d to update, %d to delete.\n",
+		counts[SyncCreate], counts[SyncUpdate], counts[SyncDelete])
+
+	return b.String()
+}
+
+// Provision idempotently converges the workspace with config, for environment
+// bootstrap and disaster recovery. Templates, destinations, webhooks and API
+// key scopes are created or updated to match config; with Prune, templates,
+// destinations and webhooks missing from config are deleted. API keys are
+// never revoked by Provision.
+//
+// When an error occurs while applying the plan, the changes before the
+// failing one have already been applied. Running Provision again resumes.
+func (c *Client) Provision(ctx context.Context, config *ProvisionConfig, opts *SyncOptions) (*ProvisionPlan, error) {
+	if config == nil {
+		return nil, NewValidationError("Provision config is required", nil)
+	}
+	if opts == nil {
+		opts = &SyncOptions{}
+	}
+
+	plan := &ProvisionPlan{}
+	var steps []func() error
+
+	// Templates
+	templatePlan, err := c.Templates.plan(ctx, config.Templates, opts.Prune)
+	if err != nil {
+		return nil, err
+	}
+	for _, change := range templatePlan.Changes {
+		plan.Changes = append(plan.Changes, ProvisionChange{Action: change.Action, Kind: ResourceTemplate, Name: change.TemplateID})
+	}
+	steps = append(steps, func() error {
+		return c.Templates.apply(ctx, templatePlan, config.Templates)
+	})
+
+	// Destinations
+	destinations, err := collectPages(func(opts *ListOptions) ([]*Destination, string, error) {
+		page, err := c.Deliveries.List(ctx, opts)
+		if err != nil {
+			return nil, "", err
+		}
+		return page.Destinations, page.NextCursor, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	remoteDestinations := make(map[string]*Destination, len(destinations))
+	for _, destination := range destinations {
+		remoteDestinations[destination.Name] = destination
+	}
+	for _, destination := range config.Destinations {
+		destination := destination
+		current, ok := remoteDestinations[destination.Name]
+		delete(remoteDestinations, destination.Name)
+
+		switch {
+		case !ok:
+			plan.add(SyncCreate, ResourceDestination, destination.Name)
+			steps = append(steps, func() error {
+				_, err := c.Deliveries.Create(ctx, destination)
+				return err
+			})
+		case current.Type != destination.Type || !equalJSON(current.Config, destination.Config):
+			plan.add(SyncUpdate, ResourceDestination, destination.Name)
+			steps = append(steps, func() error {
+				_, err := c.Deliveries.Update(ctx, current.ID, destination)
+				return err
+			})
+		}
+	}
+	if opts.Prune {
+		for _, name := range sortedKeys(remoteDestinations) {
+			current := remoteDestinations[name]
+			plan.add(SyncDelete, ResourceDestination, name)
+			steps = append(steps, func() error {
+				return c.Deliveries.Delete(ctx, current.ID)
+			})
+		}
+	}
+
+	// Webhooks
+	webhooks, err := collectPages(func(opts *ListOptions) ([]*Webhook, string, error) {
+		page, err := c.Webhooks.List(ctx, opts)
+		if err != nil {
+			return nil, "", err
+		}
+		return page.Webhooks, page.NextCursor, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	remoteWebhooks := make(map[string]*Webhook, len(webhooks))
+	for _, webhook := range webhooks {
+		remoteWebhooks[webhook.URL] = webhook
+	}
+	for _, webhook := range config.Webhooks {
+		webhook := webhook
+		current, ok := remoteWebhooks[webhook.URL]
+		delete(remoteWebhooks, webhook.URL)
+
+		// Compare against the version Create pins by default.
+		if webhook.EventVersion == 0 {
+			pinned := *webhook
+			pinned.EventVersion = CurrentEventVersion
+			webhook = &pinned
+		}
+
+		switch {
+		case !ok:
+			plan.add(SyncCreate, ResourceWebhook, webhook.URL)
+			steps = append(steps, func() error {
+				_, err := c.Webhooks.Create(ctx, webhook)
+				return err
+			})
+		case !equalSets(current.Events, webhook.Events) || current.Description != webhook.Description || current.Disabled != webhook.Disabled ||
+			!equalSets(current.TemplateIDs, webhook.TemplateIDs) || current.EventVersion != webhook.EventVersion:
+			plan.add(SyncUpdate, ResourceWebhook, webhook.URL)
+			steps = append(steps, func() error {
+				_, err := c.Webhooks.Update(ctx, current.ID, webhook)
+				return err
+			})
+		}
+	}
+	if opts.Prune {
+		for _, webhookURL := range sortedKeys(remoteWebhooks) {
+			current := remoteWebhooks[webhookURL]
+			plan.add(SyncDelete, ResourceWebhook, webhookURL)
+			steps = append(steps, func() error {
+				return c.Webhooks.Delete(ctx, current.ID)
+			})
+		}
+	}
+
+	// API keys
+	keys, err := collectPages(func(opts *ListOptions) ([]*APIKey, string, error) {
+		page, err := c.APIKeys.List(ctx, opts)
+		if err != nil {
+			return nil, "", err
+		}
+		return page.APIKeys, page.NextCursor, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	remoteKeys := make(map[string]*APIKey, len(keys))
+	for _, key := range keys {
+		remoteKeys[key.Name] = key
+	}
+	for _, key := range config.APIKeys {
+		key := key
+		current, ok := remoteKeys[key.Name]
+
+		switch {
+		case !ok:
+			plan.add(SyncCreate, ResourceAPIKey, key.Name)
+			steps = append(steps, func() error {
+				created, err := c.APIKeys.Create(ctx, key.Name, key.Scopes)
+				if err != nil {
+					return err
+				}
+				plan.CreatedAPIKeys = append(plan.CreatedAPIKeys, created)
+				return nil
+			})
+		case !equalSets(current.Scopes, key.Scopes):
+			plan.add(SyncUpdate, ResourceAPIKey, key.Name)
+			steps = append(steps, func() error {
+				_, err := c.APIKeys.UpdateScopes(ctx, current.ID, key.Scopes)
+				return err
+			})
+		}
+	}
+
+	if opts.Output != nil {
+		fmt.Fprint(opts.Output, plan.String())
+	}
+
+	if opts.DryRun {
+		return plan, nil
+	}
+
+	for _, step := range steps {
+		if err := step(); err != nil {
+			return plan, err
+		}
+	}
+
+	return plan, nil
+}
+
+func (p *ProvisionPlan) add(action SyncAction, kind ResourceKind, name string) {
+	p.Changes = append(p.Changes, ProvisionChange{Action: action, Kind: kind, Name: name})
+}
+
+// equalSets reports whether a and b contain the same strings, ignoring order.
+func equalSets(a, b []string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+
+	sortedA := append([]string(nil), a...)
+	sortedB := append([]string(nil), b...)
+	sort.Strings(sortedA)
+	sort.Strings(sortedB)
+
+	return reflect.DeepEqual(sortedA, sortedB)
+}
+
+// equalJSON reports whether a and b have the same JSON encoding, so that
+// values decoded from a config file compare equal to values from the API.
+func equalJSON(a, b interface{}) bool {
+	encodedA, errA := json.Marshal(a)
+	encodedB, errB := json.Marshal(b)
+	return errA == nil && errB == nil && string(encodedA) == string(encodedB)
+}
+
+// sortedKeys returns the keys of m in sorted order.
+func sortedKeys[V any](m map[string]V) []string {
+	keys := make([]string, 0, len(m))
+	for key := range m {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
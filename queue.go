@@ -0,0 +1,346 @@
+package documentstack
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+)
+
+const (
+	defaultQueueMaxAttempts   = 3
+	defaultQueueRetryDelay    = 30 * time.Second
+	defaultQueueMaxRetryDelay = 15 * time.Minute
+)
+
+// QueuedJob is a generation persisted in a QueueStore.
+type QueuedJob struct {
+	ID         string           `json:"id"`
+	TemplateID string           `json:"templateId"`
+	Request    *GenerateRequest `json:"request,omitempty"`
+
+	// Attempts is the number of failed attempts so far.
+	Attempts int `json:"attempts"`
+
+	// LastError is the error message of the last failed attempt.
+	LastError string `json:"lastError,omitempty"`
+
+	// NextAttemptAt is the earliest time the job is retried after a failed attempt.
+	NextAttemptAt *time.Time `json:"nextAttemptAt,omitempty"`
+
+	EnqueuedAt time.Time `json:"enqueuedAt"`
+}
+
+// QueueStore persists queued jobs so they survive process restarts.
+// Implementations must be safe for concurrent use.
+type QueueStore interface {
+	// Save inserts or replaces job.
+	Save(ctx context.Context, job *QueuedJob) error
+
+	// Delete removes the job with the given ID. Deleting a missing job is not an error.
+	Delete(ctx context.Context, id string) error
+
+	// Pending returns all stored jobs.
+	Pending(ctx context.Context) ([]*QueuedJob, error)
+}
+
+// QueueOptions controls how a Queue processes jobs.
+type QueueOptions struct {
+	// Concurrency is the number of jobs processed in parallel.
+	// Default: 4
+	Concurrency int
+
+	// MaxAttempts is the number of attempts before a job is dead-lettered.
+	// Each attempt includes the client's own retries.
+	// Default: 3
+	MaxAttempts int
+
+	// RetryDelay is the delay before retrying a failed job. It doubles with
+	// each further failed attempt, up to MaxRetryDelay, so jobs are not
+	// dead-lettered within moments during an API outage.
+	// Default: 30s
+	RetryDelay time.Duration
+
+	// MaxRetryDelay caps the delay between attempts.
+	// Default: 15m
+	MaxRetryDelay time.Duration
+
+	// OnComplete is called with each generated PDF. The job is only removed
+	// from the store once OnComplete returns nil, so processing is
+	// at-least-once. A returned error counts as a failed attempt.
+	OnComplete func(ctx context.Context, job *QueuedJob, response *GenerateResponse) error
+
+	// DeadLetter, if set, receives jobs that failed MaxAttempts times before
+	// they are removed from the store.
+	DeadLetter func(ctx context.Context, job *QueuedJob, err error)
+}
+
+// Queue runs generations from a persistent QueueStore, so bulk jobs
+// interrupted by a restart are resumed by the next Run.
+//
+// Example:
+//
+//	queue := documentstack.NewQueue(client, documentstack.NewMemoryQueueStore(), &documentstack.QueueOptions{
+//		OnComplete: func(ctx context.Context, job *documentstack.QueuedJob, response *documentstack.GenerateResponse) error {
+//			return upload(ctx, job.ID, response.PDF)
+//		},
+//	})
+//	for _, statement := range statements {
+//		queue.Enqueue(ctx, "statement", statement)
+//	}
+//	err := queue.Run(ctx)
+type Queue struct {
+	client *Client
+	store  QueueStore
+	opts   QueueOptions
+}
+
+// NewQueue creates a queue that generates with client and persists jobs in store.
+func NewQueue(client *Client, store QueueStore, opts *QueueOptions) *Queue {
+	queue := &Queue{client: client, store: store}
+	if opts != nil {
+		queue.opts = *opts
+	}
+	if queue.opts.Concurrency <= 0 {
+		queue.opts.Concurrency = defaultBatchConcurrency
+	}
+	if queue.opts.MaxAttempts <= 0 {
+		queue.opts.MaxAttempts = defaultQueueMaxAttempts
+	}
+	if queue.opts.RetryDelay <= 0 {
+		queue.opts.RetryDelay = defaultQueueRetryDelay
+	}
+	if queue.opts.MaxRetryDelay <= 0 {
+		queue.opts.MaxRetryDelay = defaultQueueMaxRetryDelay
+	}
+	return queue
+}
+
+// Enqueue persists a generation to be processed by Run.
+func (q *Queue) Enqueue(ctx context.Context, templateID string, request *GenerateRequest) (*QueuedJob, error) {
+	if templateID == "" {
+		return nil, NewValidationError("Template ID is required", nil)
+	}
+
+	id, err := newJobID()
+	if err != nil {
+		return nil, err
+	}
+
+	job := &QueuedJob{
+		ID:         id,
+		TemplateID: templateID,
+		Request:    request,
+		EnqueuedAt: time.Now().UTC(),
+	}
+	if err := q.store.Save(ctx, job); err != nil {
+		return nil, err
+	}
+
+	return job, nil
+}
+
+// Run processes every pending job in the store, including jobs left over from
+// a previous process, and returns once all of them completed or were
+// dead-lettered. Failed jobs are retried after their backoff delay (see
+// QueueOptions.RetryDelay), so while jobs keep failing Run waits between
+// attempts and only returns after their last attempt. Run stops early and
+// returns ctx's error when ctx is done, leaving unfinished jobs in the store.
+// When the client is closed, Run finishes the jobs in progress and returns
+// ErrClientClosed.
+func (q *Queue) Run(ctx context.Context) error {
+	ctx, done, err := q.client.lifecycle.begin(ctx)
+	if err != nil {
+		return err
+	}
+	defer done()
+
+	closing := q.client.lifecycle.closing.Done()
+
+	for {
+		select {
+		case <-closing:
+			return ErrClientClosed
+		default:
+		}
+
+		pending, err := q.store.Pending(ctx)
+		if err != nil {
+			return err
+		}
+		if len(pending) == 0 {
+			return nil
+		}
+
+		jobs, next := dueJobs(pending, time.Now())
+		if len(jobs) == 0 {
+			timer := time.NewTimer(time.Until(next))
+			select {
+			case <-timer.C:
+				continue
+			case <-ctx.Done():
+				timer.Stop()
+				return ctx.Err()
+			case <-closing:
+				timer.Stop()
+				return ErrClientClosed
+			}
+		}
+
+		sort.Slice(jobs, func(i, j int) bool {
+			return jobs[i].EnqueuedAt.Before(jobs[j].EnqueuedAt)
+		})
+
+		work := make(chan *QueuedJob)
+		errs := make(chan error, q.opts.Concurrency)
+		var wg sync.WaitGroup
+
+		for i := 0; i < q.opts.Concurrency; i++ {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				for job := range work {
+					if err := q.process(ctx, job); err != nil {
+						select {
+						case errs <- err:
+						default:
+						}
+					}
+				}
+			}()
+		}
+
+	dispatch:
+		for _, job := range jobs {
+			select {
+			case work <- job:
+			case <-ctx.Done():
+				break dispatch
+			case <-closing:
+				break dispatch
+			}
+		}
+		close(work)
+		wg.Wait()
+
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+
+		select {
+		case err := <-errs:
+			return err
+		default:
+		}
+	}
+}
+
+// process runs a single attempt of job and updates the store. Only store
+// failures are returned.
+func (q *Queue) process(ctx context.Context, job *QueuedJob) error {
+	response, err := q.client.Generate(ctx, job.TemplateID, job.Request)
+	if err == nil && q.opts.OnComplete != nil {
+		err = q.opts.OnComplete(ctx, job, response)
+	}
+
+	if err == nil {
+		return q.store.Delete(ctx, job.ID)
+	}
+
+	if ctx.Err() != nil || errors.Is(err, ErrClientClosed) {
+		// Interrupted, not failed: leave the job for the next Run.
+		return nil
+	}
+
+	job.Attempts++
+	job.LastError = err.Error()
+
+	if job.Attempts >= q.opts.MaxAttempts {
+		if q.opts.DeadLetter != nil {
+			q.opts.DeadLetter(ctx, job, err)
+		}
+		return q.store.Delete(ctx, job.ID)
+	}
+
+	next := time.Now().Add(q.retryDelay(job.Attempts)).UTC()
+	job.NextAttemptAt = &next
+	return q.store.Save(ctx, job)
+}
+
+// retryDelay returns the backoff after the given number of failed attempts.
+func (q *Queue) retryDelay(attempts int) time.Duration {
+	delay := q.opts.RetryDelay << (attempts - 1)
+	if delay <= 0 || delay > q.opts.MaxRetryDelay {
+		delay = q.opts.MaxRetryDelay
+	}
+	return delay
+}
+
+// dueJobs returns the jobs that may be attempted at now and, if there are
+// none, the time the earliest job becomes due.
+func dueJobs(jobs []*QueuedJob, now time.Time) ([]*QueuedJob, time.Time) {
+	var due []*QueuedJob
+	var next time.Time
+	for _, job := range jobs {
+		if job.NextAttemptAt == nil || !job.NextAttemptAt.After(now) {
+			due = append(due, job)
+		} else if next.IsZero() || job.NextAttemptAt.Before(next) {
+			next = *job.NextAttemptAt
+		}
+	}
+	return due, next
+}
+
+// newJobID returns a random job identifier.
+func newJobID() (string, error) {
+	var b [16]byte
+	if _, err := rand.Read(b[:]); err != nil {
+		return "", &DocumentStackError{Message: fmt.Sprintf("failed to generate job ID: %v", err)}
+	}
+	return hex.EncodeToString(b[:]), nil
+}
+
+// MemoryQueueStore is an in-memory QueueStore. Jobs do not survive restarts;
+// it is intended for development and tests.
+type MemoryQueueStore struct {
+	mu   sync.Mutex
+	jobs map[string]QueuedJob
+}
+
+// NewMemoryQueueStore creates an empty in-memory queue store.
+func NewMemoryQueueStore() *MemoryQueueStore {
+	return &MemoryQueueStore{jobs: make(map[string]QueuedJob)}
+}
+
+// Save implements QueueStore.
+func (s *MemoryQueueStore) Save(ctx context.Context, job *QueuedJob) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.jobs[job.ID] = *job
+	return nil
+}
+
+// Delete implements QueueStore.
+func (s *MemoryQueueStore) Delete(ctx context.Context, id string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	delete(s.jobs, id)
+	return nil
+}
+
+// Pending implements QueueStore.
+func (s *MemoryQueueStore) Pending(ctx context.Context) ([]*QueuedJob, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	jobs := make([]*QueuedJob, 0, len(s.jobs))
+	for _, job := range s.jobs {
+		job := job
+		jobs = append(jobs, &job)
+	}
+	return jobs, nil
+}
@@ -0,0 +1,173 @@
+package documentstack
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+)
+
+// SQLQueueStore is a QueueStore backed by a database/sql table with the schema:
+//
+//	CREATE TABLE documentstack_jobs (
+//		id      VARCHAR(64) PRIMARY KEY,
+//		payload TEXT NOT NULL
+//	)
+//
+// Any driver works; set NumberedPlaceholders for drivers using $1-style
+// placeholders such as PostgreSQL.
+type SQLQueueStore struct {
+	// DB is the database holding the table.
+	DB *sql.DB
+
+	// Table is the table name.
+	// Default: "documentstack_jobs"
+	Table string
+
+	// NumberedPlaceholders uses $1, $2 instead of ? placeholders.
+	NumberedPlaceholders bool
+}
+
+func (s *SQLQueueStore) table() string {
+	if s.Table == "" {
+		return "documentstack_jobs"
+	}
+	return s.Table
+}
+
+func (s *SQLQueueStore) placeholder(n int) string {
+	if s.NumberedPlaceholders {
+		return fmt.Sprintf("$%d", n)
+	}
+	return "?"
+}
+
+// Save implements QueueStore. The job is replaced in a transaction so that no
+// dialect-specific upsert is required.
+func (s *SQLQueueStore) Save(ctx context.Context, job *QueuedJob) error {
+	payload, err := json.Marshal(job)
+	if err != nil {
+		return &DocumentStackError{Message: fmt.Sprintf("failed to encode job: %v", err)}
+	}
+
+	tx, err := s.DB.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	deleteQuery := fmt.Sprintf("DELETE FROM %s WHERE id = %s", s.table(), s.placeholder(1))
+	if _, err := tx.ExecContext(ctx, deleteQuery, job.ID); err != nil {
+		return err
+	}
+
+	insertQuery := fmt.Sprintf("INSERT INTO %s (id, payload) VALUES (%s, %s)", s.table(), s.placeholder(1), s.placeholder(2))
+	if _, err := tx.ExecContext(ctx, insertQuery, job.ID, string(payload)); err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
+
+// Delete implements QueueStore.
+func (s *SQLQueueStore) Delete(ctx context.Context, id string) error {
+	query := fmt.Sprintf("DELETE FROM %s WHERE id = %s", s.table(), s.placeholder(1))
+	_, err := s.DB.ExecContext(ctx, query, id)
+	return err
+}
+
+// Pending implements QueueStore.
+func (s *SQLQueueStore) Pending(ctx context.Context) ([]*QueuedJob, error) {
+	rows, err := s.DB.QueryContext(ctx, fmt.Sprintf("SELECT payload FROM %s", s.table()))
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var jobs []*QueuedJob
+	for rows.Next() {
+		var payload string
+		if err := rows.Scan(&payload); err != nil {
+			return nil, err
+		}
+
+		var job QueuedJob
+		if err := json.Unmarshal([]byte(payload), &job); err != nil {
+			return nil, &DocumentStackError{Message: fmt.Sprintf("failed to decode job: %v", err)}
+		}
+		jobs = append(jobs, &job)
+	}
+
+	return jobs, rows.Err()
+}
+
+// RedisHashClient is the subset of a Redis client used by RedisQueueStore.
+// It keeps the SDK free of a Redis dependency; adapt your client with a few
+// lines, e.g. for go-redis:
+//
+//	type redisAdapter struct{ *redis.Client }
+//
+//	func (a redisAdapter) HSet(ctx context.Context, key, field, value string) error {
+//		return a.Client.HSet(ctx, key, field, value).Err()
+//	}
+//	func (a redisAdapter) HDel(ctx context.Context, key, field string) error {
+//		return a.Client.HDel(ctx, key, field).Err()
+//	}
+//	func (a redisAdapter) HGetAll(ctx context.Context, key string) (map[string]string, error) {
+//		return a.Client.HGetAll(ctx, key).Result()
+//	}
+type RedisHashClient interface {
+	HSet(ctx context.Context, key, field, value string) error
+	HDel(ctx context.Context, key, field string) error
+	HGetAll(ctx context.Context, key string) (map[string]string, error)
+}
+
+// RedisQueueStore is a QueueStore that keeps jobs in a single Redis hash.
+type RedisQueueStore struct {
+	// Client is the Redis client.
+	Client RedisHashClient
+
+	// Key is the hash key.
+	// Default: "documentstack:jobs"
+	Key string
+}
+
+func (s *RedisQueueStore) key() string {
+	if s.Key == "" {
+		return "documentstack:jobs"
+	}
+	return s.Key
+}
+
+// Save implements QueueStore.
+func (s *RedisQueueStore) Save(ctx context.Context, job *QueuedJob) error {
+	payload, err := json.Marshal(job)
+	if err != nil {
+		return &DocumentStackError{Message: fmt.Sprintf("failed to encode job: %v", err)}
+	}
+	return s.Client.HSet(ctx, s.key(), job.ID, string(payload))
+}
+
+// Delete implements QueueStore.
+func (s *RedisQueueStore) Delete(ctx context.Context, id string) error {
+	return s.Client.HDel(ctx, s.key(), id)
+}
+
+// Pending implements QueueStore.
+func (s *RedisQueueStore) Pending(ctx context.Context) ([]*QueuedJob, error) {
+	entries, err := s.Client.HGetAll(ctx, s.key())
+	if err != nil {
+		return nil, err
+	}
+
+	jobs := make([]*QueuedJob, 0, len(entries))
+	for _, payload := range entries {
+		var job QueuedJob
+		if err := json.Unmarshal([]byte(payload), &job); err != nil {
+			return nil, &DocumentStackError{Message: fmt.Sprintf("failed to decode job: %v", err)}
+		}
+		jobs = append(jobs, &job)
+	}
+
+	return jobs, nil
+}
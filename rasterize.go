@@ -0,0 +1,81 @@
+package documentstack
+
+import "context"
+
+// ImageFormat is the format of rendered page images.
+type ImageFormat string
+
+// Image formats.
+const (
+	ImagePNG  ImageFormat = "png"
+	ImageJPEG ImageFormat = "jpeg"
+	ImageWebP ImageFormat = "webp"
+)
+
+// RasterOptions controls how pages are rendered to images.
+type RasterOptions struct {
+	// Pages selects the pages to render, e.g. "1", "1-3,5" or "last".
+	// Default: all pages
+	Pages string `json:"pages,omitempty"`
+
+	// DPI is the rendering resolution.
+	// Default: 150
+	DPI int `json:"dpi,omitempty"`
+
+	// Format is the image format.
+	// Default: ImagePNG
+	Format ImageFormat `json:"format,omitempty"`
+
+	// Quality is the JPEG/WebP quality between 1 and 100.
+	Quality int `json:"quality,omitempty"`
+}
+
+// PageImage is a rendered page.
+type PageImage struct {
+	// Page is the 1-based page number.
+	Page int `json:"page"`
+
+	// Width is the image width in pixels.
+	Width int `json:"width"`
+
+	// Height is the image height in pixels.
+	Height int `json:"height"`
+
+	// ContentType is the MIME type of Data.
+	ContentType string `json:"contentType"`
+
+	// Data is the image content.
+	Data []byte `json:"data"`
+}
+
+// RasterResult holds the rendered pages.
+type RasterResult struct {
+	Pages []PageImage `json:"pages"`
+}
+
+// Rasterize renders pages of a document to images, e.g. for thumbnails or
+// page carousels. opts may be nil.
+//
+// Example:
+//
+//	result, err := client.Rasterize(ctx, documentstack.SourceFromDocument(id), &documentstack.RasterOptions{
+//		Pages: "1",
+//		DPI:   72,
+//	})
+func (c *Client) Rasterize(ctx context.Context, source *Source, opts *RasterOptions) (*RasterResult, error) {
+	if err := source.validate(); err != nil {
+		return nil, err
+	}
+
+	body := map[string]interface{}{
+		"source":  source,
+		"options": opts,
+	}
+
+	var result RasterResult
+	if err := c.queryJSON(ctx, "/api/v1/rasterize", body, &result); err != nil {
+		return nil, err
+	}
+
+	return &result, nil
+}
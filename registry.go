@@ -0,0 +1,190 @@
+package documentstack
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// PinnedTemplate is a template the application expects to exist in a
+// particular form. Zero fields are not checked.
+type PinnedTemplate struct {
+	ID string
+
+	// Version is the expected Template.Version.
+	Version int
+
+	// SchemaHash is the expected SchemaHash of the template's schema.
+	SchemaHash string
+}
+
+// DriftKind is the way a template differs from its pin.
+type DriftKind string
+
+// Kinds of template drift.
+const (
+	DriftMissing DriftKind = "missing"
+	DriftVersion DriftKind = "version"
+	DriftSchema  DriftKind = "schema"
+)
+
+// TemplateDrift describes a template that differs from its pin.
+type TemplateDrift struct {
+	TemplateID string
+	Kind       DriftKind
+
+	// Expected and Actual are the pinned and current version or schema hash.
+	Expected string
+	Actual   string
+}
+
+func (d TemplateDrift) String() string {
+	if d.Kind == DriftMissing {
+		return fmt.Sprintf("template %q is missing", d.TemplateID)
+	}
+	return fmt.Sprintf("template %q %s drifted: expected %s, found %s", d.TemplateID, d.Kind, d.Expected, d.Actual)
+}
+
+// TemplateDriftError is returned by TemplateRegistry.Verify when templates
+// differ from their pins.
+type TemplateDriftError struct {
+	Drift []TemplateDrift
+}
+
+func (e *TemplateDriftError) Error() string {
+	messages := make([]string, len(e.Drift))
+	for i, drift := range e.Drift {
+		messages[i] = drift.String()
+	}
+	return strings.Join(messages, "; ")
+}
+
+// TemplateRegistry pins the templates an application depends on, so drift
+// between production templates and what the binary expects is detected at
+// startup rather than in generated documents.
+//
+// Example:
+//
+//	var templates = documentstack.NewTemplateRegistry(
+//		documentstack.PinnedTemplate{ID: "invoice", SchemaHash: "sha256:9f2c..."},
+//		documentstack.PinnedTemplate{ID: "receipt", Version: 7},
+//	)
+//
+//	if err := templates.Verify(ctx, client); err != nil {
+//		log.Fatalf("template drift: %v", err) // or log a warning
+//	}
+type TemplateRegistry struct {
+	pins []PinnedTemplate
+}
+
+// NewTemplateRegistry creates a registry of pinned templates.
+func NewTemplateRegistry(pins ...PinnedTemplate) *TemplateRegistry {
+	return &TemplateRegistry{pins: pins}
+}
+
+// Verify checks every pinned template against the API. If any template
+// drifted it returns a *TemplateDriftError listing all differences; other
+// errors are returned as they occur.
+func (r *TemplateRegistry) Verify(ctx context.Context, client *Client) error {
+	var drifts []TemplateDrift
+
+	for _, pin := range r.pins {
+		template, err := client.Templates.Get(ctx, pin.ID)
+		if err != nil {
+			var apiErr *APIError
+			if errors.As(err, &apiErr) && apiErr.IsNotFoundError() {
+				drifts = append(drifts, TemplateDrift{TemplateID: pin.ID, Kind: DriftMissing})
+				continue
+			}
+			return err
+		}
+
+		if pin.Version != 0 && template.Version != pin.Version {
+			drifts = append(drifts, TemplateDrift{
+				TemplateID: pin.ID,
+				Kind:       DriftVersion,
+				Expected:   fmt.Sprint(pin.Version),
+				Actual:     fmt.Sprint(template.Version),
+			})
+		}
+
+		if pin.SchemaHash != "" {
+			schema, err := client.Templates.Schema(ctx, pin.ID)
+			if err != nil {
+				return err
+			}
+			if hash := SchemaHash(schema); hash != pin.SchemaHash {
+				drifts = append(drifts, TemplateDrift{
+					TemplateID: pin.ID,
+					Kind:       DriftSchema,
+					Expected:   pin.SchemaHash,
+					Actual:     hash,
+				})
+			}
+		}
+	}
+
+	if len(drifts) > 0 {
+		return &TemplateDriftError{Drift: drifts}
+	}
+	return nil
+}
+
+// Pin returns the current pin of a template, for recording in code.
+func (s *TemplatesService) Pin(ctx context.Context, templateID string) (*PinnedTemplate, error) {
+	template, err := s.Get(ctx, templateID)
+	if err != nil {
+		return nil, err
+	}
+
+	schema, err := s.Schema(ctx, templateID)
+	if err != nil {
+		return nil, err
+	}
+
+	return &PinnedTemplate{
+		ID:         template.ID,
+		Version:    template.Version,
+		SchemaHash: SchemaHash(schema),
+	}, nil
+}
+
+// SchemaHash returns a stable hash of the variable paths, types and required
+// markers and the flags of a schema. Descriptions do not affect the hash.
+func SchemaHash(schema *TemplateSchema) string {
+	type variable struct {
+		Path     string `json:"path"`
+		Type     string `json:"type"`
+		Required bool   `json:"required"`
+	}
+	type flag struct {
+		Name    string `json:"name"`
+		Default bool   `json:"default"`
+	}
+
+	canonical := struct {
+		Variables []variable `json:"variables"`
+		Flags     []flag     `json:"flags"`
+	}{
+		Variables: make([]variable, len(schema.Variables)),
+		Flags:     make([]flag, len(schema.Flags)),
+	}
+	for i, v := range schema.Variables {
+		canonical.Variables[i] = variable{Path: v.Path, Type: v.Type, Required: v.Required}
+	}
+	for i, f := range schema.Flags {
+		canonical.Flags[i] = flag{Name: f.Name, Default: f.Default}
+	}
+	sort.Slice(canonical.Variables, func(i, j int) bool { return canonical.Variables[i].Path < canonical.Variables[j].Path })
+	sort.Slice(canonical.Flags, func(i, j int) bool { return canonical.Flags[i].Name < canonical.Flags[j].Name })
+
+	// Marshaling these types cannot fail.
+	data, _ := json.Marshal(canonical)
+	sum := sha256.Sum256(data)
+	return "sha256:" + hex.EncodeToString(sum[:])
+}
@@ -0,0 +1,74 @@
+package documentstack
+
+import "context"
+
+// PDFIssueSeverity is how serious a structural problem in a PDF is.
+type PDFIssueSeverity string
+
+// PDF issue severities.
+const (
+	// SeverityWarning issues are tolerated by most viewers.
+	SeverityWarning PDFIssueSeverity = "warning"
+
+	// SeverityError issues may prevent the document from opening.
+	SeverityError PDFIssueSeverity = "error"
+)
+
+// PDFIssue is a structural problem found in a PDF.
+type PDFIssue struct {
+	// Code is a machine-readable issue code, e.g. "truncated_xref" or "bad_stream_length".
+	Code string `json:"code"`
+
+	Severity PDFIssueSeverity `json:"severity"`
+	Message  string           `json:"message"`
+
+	// Object is the number of the affected PDF object, if any.
+	Object int `json:"object,omitempty"`
+
+	// Offset is the byte offset of the problem in the file, if known.
+	Offset int64 `json:"offset,omitempty"`
+}
+
+// PDFValidation is the result of ValidatePDF.
+type PDFValidation struct {
+	// Valid reports whether the document has no error-severity issues.
+	Valid bool `json:"valid"`
+
+	// Repairable reports whether RepairPDF can fix all error-severity issues.
+	Repairable bool `json:"repairable"`
+
+	Issues []PDFIssue `json:"issues,omitempty"`
+}
+
+// ValidatePDF checks a document for structural damage, such as a truncated
+// cross-reference table or streams with wrong lengths, without modifying it.
+//
+// Example:
+//
+//	validation, err := client.ValidatePDF(ctx, documentstack.SourceFromDocument(documentID))
+//	if err == nil && !validation.Valid && validation.Repairable {
+//		repaired, err = client.RepairPDF(ctx, documentstack.SourceFromDocument(documentID))
+//	}
+func (c *Client) ValidatePDF(ctx context.Context, source *Source) (*PDFValidation, error) {
+	if err := source.validate(); err != nil {
+		return nil, err
+	}
+
+	var result PDFValidation
+	if err := c.queryJSON(ctx, "/api/v1/validate", map[string]interface{}{"source": source}, &result); err != nil {
+		return nil, err
+	}
+
+	return &result, nil
+}
+
+// RepairPDF rebuilds a structurally damaged document and returns the repaired
+// PDF. Content that cannot be recovered, such as a corrupt page stream, is
+// dropped. The source document is not modified.
+func (c *Client) RepairPDF(ctx context.Context, source *Source) (*GenerateResponse, error) {
+	if err := source.validate(); err != nil {
+		return nil, err
+	}
+
+	return c.postPDF(ctx, "/api/v1/repair", map[string]interface{}{"source": source})
+}
@@ -0,0 +1,348 @@
+package documentstack
+
+import (
+	"bytes"
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"log"
+	"net/http"
+	"net/http/httptrace"
+	"strings"
+	"time"
+)
+
+// newRequest creates an authenticated API request for the given path.
+// contentType may be empty for requests without a body.
+func (c *Client) newRequest(ctx context.Context, method, path string, body io.Reader, contentType string) (*http.Request, error) {
+	if c.config.downloadOnly && method != http.MethodGet && method != http.MethodHead {
+		return nil, NewForbiddenError(fmt.Sprintf("%s %s is not permitted for a download-only client", method, path))
+	}
+
+	endpoint := c.config.BaseURL + path
+
+	req, err := http.NewRequestWithContext(ctx, method, endpoint, body)
+	if err != nil {
+		return nil, &NetworkError{Message: "failed to create request", Cause: err}
+	}
+
+	if contentType != "" {
+		req.Header.Set("Content-Type", contentType)
+	}
+	req.Header.Set("Authorization", fmt.Sprintf("Bearer %s", c.config.APIKey))
+
+	for key, value := range c.config.Headers {
+		req.Header.Set(key, value)
+	}
+
+	if id := onBehalfOf(ctx); id != "" {
+		req.Header.Set(onBehalfOfHeader, id)
+	}
+
+	return req, nil
+}
+
+// send executes the request and converts transport failures and non-2xx
+// responses into SDK errors. Requests with idempotent methods are retried
+// according to Config.MaxRetries. On success the caller must close the
+// response body.
+func (c *Client) send(ctx context.Context, req *http.Request) (*http.Response, error) {
+	return c.execute(ctx, req, isIdempotent(req.Method))
+}
+
+// sendRetryable is like send but retries regardless of the request method,
+// for POST endpoints such as generation. Every attempt carries the same
+// Idempotency-Key, so that the API performs side effects such as storing the
+// document or calling GenerateOptions.CallbackURL only once.
+func (c *Client) sendRetryable(ctx context.Context, req *http.Request) (*http.Response, error) {
+	if req.Header.Get(idempotencyKeyHeader) == "" {
+		key, err := newIdempotencyKey()
+		if err != nil {
+			return nil, err
+		}
+		req.Header.Set(idempotencyKeyHeader, key)
+	}
+
+	return c.execute(ctx, req, true)
+}
+
+// newIdempotencyKey returns a random key identifying one logical call.
+func newIdempotencyKey() (string, error) {
+	var b [16]byte
+	if _, err := rand.Read(b[:]); err != nil {
+		return "", &DocumentStackError{Message: fmt.Sprintf("failed to generate idempotency key: %v", err)}
+	}
+	return hex.EncodeToString(b[:]), nil
+}
+
+// attempt executes a single HTTP round trip, limited by the attempt timeout
+// for the kind of operation (see Timeouts).
+func (c *Client) attempt(ctx context.Context, req *http.Request) (*http.Response, error) {
+	if c.config.Debug {
+		log.Printf("[DocumentStack] Request: %s %s\n", req.Method, req.URL)
+	}
+
+	timeout := c.attemptTimeout(ctx)
+	attemptCtx, cancel := context.WithTimeout(ctx, timeout)
+
+	var tracer *requestTracer
+	if c.config.Trace || c.config.LatencyWarningThreshold > 0 {
+		tracer = newRequestTracer()
+		attemptCtx = httptrace.WithClientTrace(attemptCtx, tracer.clientTrace())
+		attemptCtx = context.WithValue(attemptCtx, tracerKey{}, tracer)
+	}
+
+	if c.config.SigningSecret != "" {
+		signed, err := c.sign(req)
+		if err != nil {
+			cancel()
+			return nil, err
+		}
+		req = signed
+	}
+
+	sent := time.Now()
+	resp, err := c.httpClient.Do(req.WithContext(attemptCtx))
+	if err != nil {
+		cancel()
+		switch {
+		case ctx.Err() == context.DeadlineExceeded:
+			timeout := c.config.Timeout
+			if c.config.OperationTimeout > 0 {
+				timeout = c.config.OperationTimeout
+			}
+			err = &TimeoutError{Timeout: timeout}
+		case attemptCtx.Err() == context.DeadlineExceeded:
+			err = &TimeoutError{Timeout: seconds(timeout)}
+		default:
+			err = &NetworkError{Message: "request failed", Cause: err}
+		}
+		c.traceDone(req, 0, tracer, err)
+		return nil, err
+	}
+
+	c.capabilities.observe(resp.Header)
+
+	var skewErr error
+	if c.config.SigningSecret != "" {
+		// The offset corrects later signatures. A large skew only fails the
+		// request if the API rejected its signature, never a success.
+		offset := c.clock.observe(resp, sent, time.Now())
+		if max := c.maxClockSkew(); resp.StatusCode == http.StatusUnauthorized && (offset > max || offset < -max) {
+			skewErr = &ClockSkewError{Offset: offset, Max: max}
+		}
+	}
+
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		defer cancel()
+		defer resp.Body.Close()
+		err := skewErr
+		if err == nil {
+			err = c.parseErrorResponse(resp)
+		}
+		c.traceDone(req, resp.StatusCode, tracer, err)
+		return nil, err
+	}
+
+	resp.Body = &cancelOnClose{ReadCloser: resp.Body, cancel: cancel}
+	if tracer != nil {
+		statusCode := resp.StatusCode
+		resp.Body = &tracedBody{ReadCloser: resp.Body, done: func() {
+			c.traceDone(req, statusCode, tracer, nil)
+		}}
+	}
+	return resp, nil
+}
+
+// Do calls an API endpoint that the SDK does not wrap yet, with the client's
+// authentication, headers, signing, retries, timeouts and tracing. in, if
+// non-nil, is sent as the JSON request body, and the JSON response is decoded
+// into out, if non-nil. path is relative to Config.BaseURL and may include a
+// query string. Errors are returned as from the wrapped methods, e.g.
+// *APIError.
+//
+// Requests with idempotent methods (GET, HEAD, OPTIONS, PUT, DELETE) are
+// retried according to Config.MaxRetries; POST and PATCH are not.
+//
+// Example:
+//
+//	var preview struct {
+//		Pages int `json:"pages"`
+//	}
+//	err := client.Do(ctx, "POST", "/api/v1/preview/page-count", map[string]string{"templateId": "invoice"}, &preview)
+func (c *Client) Do(ctx context.Context, method, path string, in, out interface{}) error {
+	if err := validateEndpoint(method, path); err != nil {
+		return err
+	}
+
+	return c.doJSON(ctx, method, path, in, out)
+}
+
+// RawResponse is an undecoded response returned by DoRaw.
+type RawResponse struct {
+	StatusCode int
+	Header     http.Header
+
+	// Body is the response body. The caller must close it.
+	Body io.ReadCloser
+}
+
+// DoRaw is like Do but sends body as is, with the given Content-Type, and
+// returns the successful response undecoded, e.g. for debugging or for
+// preview endpoints with non-JSON responses. Responses with a non-2xx status
+// are returned as errors, like from Do.
+//
+// Requests are retried as with Do if body is nil, a *bytes.Buffer, a
+// *bytes.Reader or a *strings.Reader; other bodies cannot be replayed and are
+// sent once.
+//
+// Example:
+//
+//	resp, err := client.DoRaw(ctx, "GET", "/api/v1/preview/templates/invoice/thumbnail", nil, "")
+//	if err != nil {
+//		return err
+//	}
+//	defer resp.Body.Close()
+//	log.Printf("%d %s", resp.StatusCode, resp.Header.Get("Content-Type"))
+func (c *Client) DoRaw(ctx context.Context, method, path string, body io.Reader, contentType string) (*RawResponse, error) {
+	if err := validateEndpoint(method, path); err != nil {
+		return nil, err
+	}
+
+	req, err := c.newRequest(ctx, method, path, body, contentType)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := c.send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	return &RawResponse{
+		StatusCode: resp.StatusCode,
+		Header:     resp.Header,
+		Body:       resp.Body,
+	}, nil
+}
+
+// validateEndpoint checks the method and path of a Do or DoRaw request.
+func validateEndpoint(method, path string) error {
+	if method == "" {
+		return NewValidationError("Method is required", nil)
+	}
+	if !strings.HasPrefix(path, "/") {
+		return NewValidationError(fmt.Sprintf("Path %q must start with /", path), nil)
+	}
+	return nil
+}
+
+// doJSON sends in (if non-nil) as a JSON body and decodes the JSON response
+// into out (if non-nil).
+func (c *Client) doJSON(ctx context.Context, method, path string, in, out interface{}) error {
+	req, err := c.newJSONRequest(ctx, method, path, in)
+	if err != nil {
+		return err
+	}
+
+	return c.decode(ctx, req, out)
+}
+
+// queryJSON is like doJSON for POST endpoints without side effects, such as
+// document analysis, which are retried like idempotent requests. See
+// sendRetryable.
+func (c *Client) queryJSON(ctx context.Context, path string, in, out interface{}) error {
+	ctx = withOperation(ctx, operationGenerate)
+
+	req, err := c.newJSONRequest(ctx, "POST", path, in)
+	if err != nil {
+		return err
+	}
+
+	resp, err := c.sendRetryable(ctx, req)
+	if err != nil {
+		return err
+	}
+
+	return decodeResponse(resp, out)
+}
+
+// newJSONRequest creates an API request with in (if non-nil) as its JSON body.
+func (c *Client) newJSONRequest(ctx context.Context, method, path string, in interface{}) (*http.Request, error) {
+	var body io.Reader
+	var contentType string
+
+	if in != nil {
+		data, err := json.Marshal(in)
+		if err != nil {
+			return nil, &NetworkError{Message: "failed to marshal request body", Cause: err}
+		}
+
+		if c.config.Debug {
+			log.Printf("[DocumentStack] Body: %s\n", redactJSON(data, defaultScrubbedFields))
+		}
+
+		body = bytes.NewReader(data)
+		contentType = "application/json"
+	}
+
+	return c.newRequest(ctx, method, path, body, contentType)
+}
+
+// decode sends req and decodes the JSON response into out (if non-nil).
+func (c *Client) decode(ctx context.Context, req *http.Request, out interface{}) error {
+	resp, err := c.send(ctx, req)
+	if err != nil {
+		return err
+	}
+
+	return decodeResponse(resp, out)
+}
+
+// decodeResponse decodes the JSON body of resp into out (if non-nil) and closes it.
+func decodeResponse(resp *http.Response, out interface{}) error {
+	defer resp.Body.Close()
+
+	if out == nil || resp.StatusCode == http.StatusNoContent {
+		_, _ = io.Copy(io.Discard, resp.Body)
+		return nil
+	}
+
+	if err := json.NewDecoder(resp.Body).Decode(out); err != nil {
+		return &NetworkError{Message: "failed to decode response body", Cause: err}
+	}
+
+	return nil
+}
+
+// collectPages calls fetch with successive cursors until the last page and
+// returns all items.
+func collectPages[T any](fetch func(opts *ListOptions) ([]T, string, error)) ([]T, error) {
+	var all []T
+	opts := &ListOptions{}
+
+	for {
+		items, next, err := fetch(opts)
+		if err != nil {
+			return nil, err
+		}
+
+		all = append(all, items...)
+
+		if next == "" {
+			return all, nil
+		}
+		opts.Cursor = next
+	}
+}
+
+// listPath appends the encoded list options to path.
+func listPath(path string, opts *ListOptions) string {
+	if query := opts.values().Encode(); query != "" {
+		return path + "?" + query
+	}
+	return path
+}
@@ -0,0 +1,165 @@
+package documentstack
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"time"
+)
+
+// RetentionService manages how long stored documents are kept and legal
+// holds that prevent their deletion.
+type RetentionService struct {
+	client *Client
+}
+
+// RetentionPolicy deletes stored documents matching its conditions a number
+// of days after they were created. When several policies match a document,
+// the longest retention applies.
+type RetentionPolicy struct {
+	// ID is the unique policy identifier. Set by the API.
+	ID string `json:"id,omitempty"`
+
+	// Name is the human-readable policy name.
+	Name string `json:"name"`
+
+	// TemplateID limits the policy to documents generated from this template.
+	TemplateID string `json:"templateId,omitempty"`
+
+	// Tags limits the policy to documents with all of these tags.
+	Tags map[string]string `json:"tags,omitempty"`
+
+	// Days is the number of days documents are kept.
+	Days int `json:"days"`
+
+	// CreatedAt is the time the policy was created. Set by the API.
+	CreatedAt time.Time `json:"createdAt"`
+}
+
+// RetentionPolicyList is a page of retention policies.
+type RetentionPolicyList struct {
+	Policies   []*RetentionPolicy `json:"policies"`
+	NextCursor string             `json:"nextCursor,omitempty"`
+}
+
+// LegalHold prevents a stored document from being deleted, by retention
+// policies or by DocumentsService.Delete, until it is released.
+type LegalHold struct {
+	ID         string `json:"id"`
+	DocumentID string `json:"documentId"`
+
+	// Reason is recorded for audits, e.g. a case number.
+	Reason string `json:"reason"`
+
+	CreatedAt time.Time `json:"createdAt"`
+}
+
+// LegalHoldList is a page of legal holds.
+type LegalHoldList struct {
+	Holds      []*LegalHold `json:"holds"`
+	NextCursor string       `json:"nextCursor,omitempty"`
+}
+
+// ListPolicies returns a page of retention policies.
+func (s *RetentionService) ListPolicies(ctx context.Context, opts *ListOptions) (*RetentionPolicyList, error) {
+	var result RetentionPolicyList
+	if err := s.client.doJSON(ctx, "GET", listPath("/api/v1/retention/policies", opts), nil, &result); err != nil {
+		return nil, err
+	}
+
+	return &result, nil
+}
+
+// CreatePolicy creates a retention policy.
+//
+// Example:
+//
+//	policy, err := client.Retention.CreatePolicy(ctx, &documentstack.RetentionPolicy{
+//		Name:       "Invoices: 10 years",
+//		TemplateID: "invoice",
+//		Days:       3653,
+//	})
+func (s *RetentionService) CreatePolicy(ctx context.Context, policy *RetentionPolicy) (*RetentionPolicy, error) {
+	if policy == nil || policy.Name == "" {
+		return nil, NewValidationError("Retention policy name is required", nil)
+	}
+	if policy.Days <= 0 {
+		return nil, NewValidationError("Retention days must be positive", nil)
+	}
+
+	var result RetentionPolicy
+	if err := s.client.doJSON(ctx, "POST", "/api/v1/retention/policies", policy, &result); err != nil {
+		return nil, err
+	}
+
+	return &result, nil
+}
+
+// UpdatePolicy replaces an existing retention policy.
+func (s *RetentionService) UpdatePolicy(ctx context.Context, policyID string, policy *RetentionPolicy) (*RetentionPolicy, error) {
+	if policyID == "" {
+		return nil, NewValidationError("Retention policy ID is required", nil)
+	}
+	if policy == nil || policy.Days <= 0 {
+		return nil, NewValidationError("Retention days must be positive", nil)
+	}
+
+	var result RetentionPolicy
+	endpoint := fmt.Sprintf("/api/v1/retention/policies/%s", url.PathEscape(policyID))
+	if err := s.client.doJSON(ctx, "PUT", endpoint, policy, &result); err != nil {
+		return nil, err
+	}
+
+	return &result, nil
+}
+
+// DeletePolicy deletes a retention policy. Documents it covered are kept
+// until another policy applies.
+func (s *RetentionService) DeletePolicy(ctx context.Context, policyID string) error {
+	if policyID == "" {
+		return NewValidationError("Retention policy ID is required", nil)
+	}
+
+	endpoint := fmt.Sprintf("/api/v1/retention/policies/%s", url.PathEscape(policyID))
+	return s.client.doJSON(ctx, "DELETE", endpoint, nil, nil)
+}
+
+// PlaceHold places a legal hold on a stored document.
+func (s *RetentionService) PlaceHold(ctx context.Context, documentID, reason string) (*LegalHold, error) {
+	if documentID == "" {
+		return nil, NewValidationError("Document ID is required", nil)
+	}
+	if reason == "" {
+		return nil, NewValidationError("Legal hold reason is required", nil)
+	}
+
+	body := map[string]string{"documentId": documentID, "reason": reason}
+
+	var result LegalHold
+	if err := s.client.doJSON(ctx, "POST", "/api/v1/retention/holds", body, &result); err != nil {
+		return nil, err
+	}
+
+	return &result, nil
+}
+
+// ListHolds returns a page of active legal holds.
+func (s *RetentionService) ListHolds(ctx context.Context, opts *ListOptions) (*LegalHoldList, error) {
+	var result LegalHoldList
+	if err := s.client.doJSON(ctx, "GET", listPath("/api/v1/retention/holds", opts), nil, &result); err != nil {
+		return nil, err
+	}
+
+	return &result, nil
+}
+
+// ReleaseHold releases a legal hold. The document becomes subject to
+// retention policies again.
+func (s *RetentionService) ReleaseHold(ctx context.Context, holdID string) error {
+	if holdID == "" {
+		return NewValidationError("Legal hold ID is required", nil)
+	}
+
+	endpoint := fmt.Sprintf("/api/v1/retention/holds/%s", url.PathEscape(holdID))
+	return s.client.doJSON(ctx, "DELETE", endpoint, nil, nil)
+}
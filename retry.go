@@ -0,0 +1,142 @@
+package documentstack
+
+import (
+	"context"
+	"io"
+	"log"
+	"math/rand"
+	"net/http"
+	"time"
+)
+
+const (
+	retryBaseDelay = 500 * time.Millisecond
+	retryMaxDelay  = 30 * time.Second
+)
+
+// execute runs req, retrying retryable failures while the retry budget and the
+// ctx deadline allow. A retry is not started if the remaining time until the
+// deadline is shorter than the backoff delay plus the duration of the previous
+// attempt, since it would most likely not complete.
+func (c *Client) execute(ctx context.Context, req *http.Request, retryable bool) (*http.Response, error) {
+	ctx, done, err := c.lifecycle.begin(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	cancel := done
+	if c.config.OperationTimeout > 0 {
+		var cancelTimeout context.CancelFunc
+		ctx, cancelTimeout = context.WithTimeout(ctx, time.Duration(c.config.OperationTimeout)*time.Second)
+		cancel = func() {
+			cancelTimeout()
+			done()
+		}
+	}
+
+	// Requests whose body cannot be replayed are never retried.
+	if req.Body != nil && req.Body != http.NoBody && req.GetBody == nil {
+		retryable = false
+	}
+
+	for attempt := 0; ; attempt++ {
+		attemptReq := req.WithContext(ctx)
+		if attempt > 0 && req.GetBody != nil {
+			body, err := req.GetBody()
+			if err != nil {
+				cancel()
+				return nil, &NetworkError{Message: "failed to rewind request body", Cause: err}
+			}
+			attemptReq.Body = body
+		}
+
+		start := time.Now()
+		var resp *http.Response
+		var err error
+		if delay, ok := hedgeDelay(ctx, req); ok {
+			resp, err = c.hedgedAttempt(ctx, attemptReq, delay)
+		} else {
+			resp, err = c.attempt(ctx, attemptReq)
+		}
+		if err == nil {
+			resp.Body = &cancelOnClose{ReadCloser: resp.Body, cancel: cancel}
+			return resp, nil
+		}
+
+		if !retryable || attempt >= c.config.MaxRetries || !shouldRetry(err) || ctx.Err() != nil {
+			cancel()
+			return nil, err
+		}
+
+		delay := retryDelay(attempt, err)
+		if deadline, ok := ctx.Deadline(); ok && time.Until(deadline) < delay+time.Since(start) {
+			cancel()
+			return nil, err
+		}
+
+		if c.config.Debug {
+			log.Printf("[DocumentStack] Retrying in %s after error: %v\n", delay, err)
+		}
+
+		timer := time.NewTimer(delay)
+		select {
+		case <-timer.C:
+		case <-ctx.Done():
+			timer.Stop()
+			cancel()
+			return nil, err
+		}
+	}
+}
+
+// shouldRetry reports whether err is a transient failure worth retrying.
+func shouldRetry(err error) bool {
+	switch e := err.(type) {
+	case *NetworkError, *TimeoutError:
+		return true
+	case *RateLimitError:
+		return true
+	case *APIError:
+		return e.StatusCode == http.StatusBadGateway ||
+			e.StatusCode == http.StatusServiceUnavailable ||
+			e.StatusCode == http.StatusGatewayTimeout
+	}
+	return false
+}
+
+// retryDelay returns the backoff before retry number attempt+1, honoring
+// Retry-After on rate limit errors.
+func retryDelay(attempt int, err error) time.Duration {
+	if rlErr, ok := err.(*RateLimitError); ok && rlErr.RetryAfter > 0 {
+		return time.Duration(rlErr.RetryAfter) * time.Second
+	}
+
+	delay := retryBaseDelay << attempt
+	if delay <= 0 || delay > retryMaxDelay {
+		delay = retryMaxDelay
+	}
+
+	// Full jitter in [delay/2, delay)
+	return delay/2 + time.Duration(rand.Int63n(int64(delay/2)))
+}
+
+// isIdempotent reports whether requests with method may be safely retried.
+func isIdempotent(method string) bool {
+	switch method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions, http.MethodPut, http.MethodDelete:
+		return true
+	}
+	return false
+}
+
+// cancelOnClose releases the operation context when the response body is closed.
+type cancelOnClose struct {
+	io.ReadCloser
+	cancel context.CancelFunc
+}
+
+func (b *cancelOnClose) Close() error {
+	err := b.ReadCloser.Close()
+	b.cancel()
+	return err
+}
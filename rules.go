@@ -0,0 +1,199 @@
+package documentstack
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"time"
+)
+
+// RuleActionType is the kind of action a rule performs.
+type RuleActionType string
+
+// Rule action types.
+const (
+	// ActionDeliver delivers the document to a delivery destination.
+	ActionDeliver RuleActionType = "deliver"
+
+	// ActionNotify sends the event to a webhook.
+	ActionNotify RuleActionType = "notify"
+)
+
+// RulesService manages server-side routing rules that act on events without
+// code in the application, e.g. "when an invoice is generated, deliver it to
+// the archive and notify the billing webhook".
+type RulesService struct {
+	client *Client
+}
+
+// RuleConditions restrict which events trigger a rule. Empty conditions
+// match every event of the rule's type.
+type RuleConditions struct {
+	// TemplateIDs limits the rule to documents generated from these templates.
+	TemplateIDs []string `json:"templateIds,omitempty"`
+
+	// Tags limits the rule to generations with all of these tags. See
+	// GenerateOptions.Tags.
+	Tags map[string]string `json:"tags,omitempty"`
+}
+
+// RuleAction is an action performed when a rule matches.
+type RuleAction struct {
+	Type RuleActionType `json:"type"`
+
+	// DestinationID is the delivery destination, for ActionDeliver.
+	DestinationID string `json:"destinationId,omitempty"`
+
+	// WebhookID is the webhook, for ActionNotify.
+	WebhookID string `json:"webhookId,omitempty"`
+}
+
+// Rule routes events to actions.
+type Rule struct {
+	// ID is the unique rule identifier. Set by the API.
+	ID string `json:"id,omitempty"`
+
+	// Name is the human-readable rule name.
+	Name string `json:"name"`
+
+	// Event is the event type that triggers the rule, e.g. EventGenerationCompleted.
+	Event string `json:"event"`
+
+	Conditions RuleConditions `json:"conditions"`
+
+	// Actions are performed in order when the rule matches.
+	Actions []RuleAction `json:"actions"`
+
+	// Disabled pauses the rule.
+	Disabled bool `json:"disabled,omitempty"`
+
+	// CreatedAt is the time the rule was created. Set by the API.
+	CreatedAt time.Time `json:"createdAt"`
+}
+
+// RuleList is a page of rules.
+type RuleList struct {
+	Rules      []*Rule `json:"rules"`
+	NextCursor string  `json:"nextCursor,omitempty"`
+}
+
+func (r *Rule) validate() error {
+	if r.Event == "" {
+		return NewValidationError("Rule event is required", nil)
+	}
+	if len(r.Actions) == 0 {
+		return NewValidationError("At least one rule action is required", nil)
+	}
+
+	for _, action := range r.Actions {
+		switch action.Type {
+		case ActionDeliver:
+			if action.DestinationID == "" {
+				return NewValidationError("Destination ID is required for deliver actions", nil)
+			}
+		case ActionNotify:
+			if action.WebhookID == "" {
+				return NewValidationError("Webhook ID is required for notify actions", nil)
+			}
+		default:
+			return NewValidationError(fmt.Sprintf("Invalid rule action type %q", action.Type), nil)
+		}
+	}
+	return nil
+}
+
+// List returns a page of rules.
+func (s *RulesService) List(ctx context.Context, opts *ListOptions) (*RuleList, error) {
+	var result RuleList
+	if err := s.client.doJSON(ctx, "GET", listPath("/api/v1/rules", opts), nil, &result); err != nil {
+		return nil, err
+	}
+
+	return &result, nil
+}
+
+// ListAll returns all rules, fetching every page. See ListAllOptions.
+func (s *RulesService) ListAll(ctx context.Context, opts *ListAllOptions) ([]*Rule, error) {
+	return listAll(ctx, opts, func(page *ListOptions) ([]*Rule, string, error) {
+		result, err := s.List(ctx, page)
+		if err != nil {
+			return nil, "", err
+		}
+		return result.Rules, result.NextCursor, nil
+	})
+}
+
+// Get retrieves a rule.
+func (s *RulesService) Get(ctx context.Context, ruleID string) (*Rule, error) {
+	if ruleID == "" {
+		return nil, NewValidationError("Rule ID is required", nil)
+	}
+
+	var result Rule
+	endpoint := fmt.Sprintf("/api/v1/rules/%s", url.PathEscape(ruleID))
+	if err := s.client.doJSON(ctx, "GET", endpoint, nil, &result); err != nil {
+		return nil, err
+	}
+
+	return &result, nil
+}
+
+// Create creates a rule.
+//
+// Example:
+//
+//	rule, err := client.Rules.Create(ctx, &documentstack.Rule{
+//		Name:       "Archive invoices",
+//		Event:      documentstack.EventGenerationCompleted,
+//		Conditions: documentstack.RuleConditions{TemplateIDs: []string{"invoice"}},
+//		Actions: []documentstack.RuleAction{
+//			{Type: documentstack.ActionDeliver, DestinationID: "dst_s3_archive"},
+//			{Type: documentstack.ActionNotify, WebhookID: "wh_billing"},
+//		},
+//	})
+func (s *RulesService) Create(ctx context.Context, rule *Rule) (*Rule, error) {
+	if rule == nil || rule.Name == "" {
+		return nil, NewValidationError("Rule name is required", nil)
+	}
+	if err := rule.validate(); err != nil {
+		return nil, err
+	}
+
+	var result Rule
+	if err := s.client.doJSON(ctx, "POST", "/api/v1/rules", rule, &result); err != nil {
+		return nil, err
+	}
+
+	return &result, nil
+}
+
+// Update replaces the configuration of an existing rule.
+func (s *RulesService) Update(ctx context.Context, ruleID string, rule *Rule) (*Rule, error) {
+	if ruleID == "" {
+		return nil, NewValidationError("Rule ID is required", nil)
+	}
+	if rule == nil {
+		return nil, NewValidationError("Rule is required", nil)
+	}
+	if err := rule.validate(); err != nil {
+		return nil, err
+	}
+
+	var result Rule
+	endpoint := fmt.Sprintf("/api/v1/rules/%s", url.PathEscape(ruleID))
+	if err := s.client.doJSON(ctx, "PUT", endpoint, rule, &result); err != nil {
+		return nil, err
+	}
+
+	return &result, nil
+}
+
+// Delete deletes a rule.
+func (s *RulesService) Delete(ctx context.Context, ruleID string) error {
+	if ruleID == "" {
+		return NewValidationError("Rule ID is required", nil)
+	}
+
+	endpoint := fmt.Sprintf("/api/v1/rules/%s", url.PathEscape(ruleID))
+	return s.client.doJSON(ctx, "DELETE", endpoint, nil, nil)
+}
@@ -0,0 +1,67 @@
+package documentstack
+
+import (
+	"context"
+	"encoding/json"
+	"reflect"
+)
+
+// ScaffoldOptions controls the starter template created by Scaffold.
+type ScaffoldOptions struct {
+	// ID and Name are set on the returned template.
+	ID   string `json:"id,omitempty"`
+	Name string `json:"name,omitempty"`
+
+	// Layout is a starting layout, e.g. "invoice", "letter" or "report".
+	// Default: a plain document listing every variable.
+	Layout string `json:"layout,omitempty"`
+}
+
+// Scaffold creates a starter template whose variables match sample, to save
+// writing the boilerplate of a new template by hand. sample is either a Go
+// value whose type describes the data, such as InvoiceData{} (field names
+// follow encoding/json rules), or a JSON document as json.RawMessage or
+// []byte. The template is not stored; edit it and save it with Push.
+//
+// Example:
+//
+//	template, err := client.Templates.Scaffold(ctx, InvoiceData{}, &documentstack.ScaffoldOptions{
+//		ID:     "invoice-v2",
+//		Layout: "invoice",
+//	})
+//	if err == nil {
+//		os.WriteFile("templates/invoice-v2.html", []byte(template.Content), 0o644)
+//	}
+func (s *TemplatesService) Scaffold(ctx context.Context, sample interface{}, opts *ScaffoldOptions) (*Template, error) {
+	if sample == nil {
+		return nil, NewValidationError("Sample is required", nil)
+	}
+
+	body := struct {
+		*ScaffoldOptions
+		Schema map[string]interface{} `json:"schema,omitempty"`
+		Sample json.RawMessage        `json:"sample,omitempty"`
+	}{ScaffoldOptions: opts}
+	if body.ScaffoldOptions == nil {
+		body.ScaffoldOptions = &ScaffoldOptions{}
+	}
+
+	switch sample := sample.(type) {
+	case json.RawMessage:
+		body.Sample = sample
+	case []byte:
+		body.Sample = sample
+	default:
+		body.Schema = jsonSchemaFor(reflect.TypeOf(sample))
+	}
+	if body.Sample != nil && !json.Valid(body.Sample) {
+		return nil, NewValidationError("Sample is not valid JSON", nil)
+	}
+
+	var result Template
+	if err := s.client.queryJSON(ctx, "/api/v1/templates/scaffold", body, &result); err != nil {
+		return nil, err
+	}
+
+	return &result, nil
+}
@@ -0,0 +1,148 @@
+package documentstack
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"time"
+)
+
+// SchedulesService manages recurring server-side generation jobs.
+type SchedulesService struct {
+	client *Client
+}
+
+// Schedule is a recurring generation job run by the API.
+type Schedule struct {
+	// ID is the unique schedule identifier. Set by the API.
+	ID string `json:"id,omitempty"`
+
+	// Name is the human-readable schedule name.
+	Name string `json:"name"`
+
+	// Cron is a five-field cron expression, e.g. "0 6 1 * *" for 06:00 on the first of every month.
+	Cron string `json:"cron"`
+
+	// Timezone is the IANA time zone the cron expression is evaluated in.
+	// Default: "UTC"
+	Timezone string `json:"timezone,omitempty"`
+
+	// TemplateID is the template to generate.
+	TemplateID string `json:"templateId"`
+
+	// DataSource is a reference to the data source providing the template data for each run.
+	DataSource string `json:"dataSource,omitempty"`
+
+	// Data is static template data, merged under the data source's data.
+	Data map[string]interface{} `json:"data,omitempty"`
+
+	// Options contains generation options.
+	Options *GenerateOptions `json:"options,omitempty"`
+
+	// DestinationID is the delivery destination the generated documents are sent to.
+	DestinationID string `json:"destinationId,omitempty"`
+
+	// Paused stops the schedule from running until it is unpaused.
+	Paused bool `json:"paused,omitempty"`
+
+	// NextRunAt is the time of the next run. Set by the API.
+	NextRunAt *time.Time `json:"nextRunAt,omitempty"`
+
+	// CreatedAt is the time the schedule was created. Set by the API.
+	CreatedAt time.Time `json:"createdAt"`
+}
+
+// ScheduleList is a page of schedules.
+type ScheduleList struct {
+	Schedules  []*Schedule `json:"schedules"`
+	NextCursor string      `json:"nextCursor,omitempty"`
+}
+
+// List returns a page of schedules.
+func (s *SchedulesService) List(ctx context.Context, opts *ListOptions) (*ScheduleList, error) {
+	var result ScheduleList
+	if err := s.client.doJSON(ctx, "GET", listPath("/api/v1/schedules", opts), nil, &result); err != nil {
+		return nil, err
+	}
+
+	return &result, nil
+}
+
+// ListAll returns all schedules, fetching every page. See ListAllOptions.
+func (s *SchedulesService) ListAll(ctx context.Context, opts *ListAllOptions) ([]*Schedule, error) {
+	return listAll(ctx, opts, func(page *ListOptions) ([]*Schedule, string, error) {
+		result, err := s.List(ctx, page)
+		if err != nil {
+			return nil, "", err
+		}
+		return result.Schedules, result.NextCursor, nil
+	})
+}
+
+// Get retrieves a schedule.
+func (s *SchedulesService) Get(ctx context.Context, scheduleID string) (*Schedule, error) {
+	if scheduleID == "" {
+		return nil, NewValidationError("Schedule ID is required", nil)
+	}
+
+	var result Schedule
+	endpoint := fmt.Sprintf("/api/v1/schedules/%s", url.PathEscape(scheduleID))
+	if err := s.client.doJSON(ctx, "GET", endpoint, nil, &result); err != nil {
+		return nil, err
+	}
+
+	return &result, nil
+}
+
+// Create creates a schedule.
+//
+// Example:
+//
+//	schedule, err := client.Schedules.Create(ctx, &documentstack.Schedule{
+//		Name:          "Monthly statements",
+//		Cron:          "0 6 1 * *",
+//		Timezone:      "Europe/Berlin",
+//		TemplateID:    "statement",
+//		DataSource:    "ds_statements",
+//		DestinationID: "dst_s3_archive",
+//	})
+func (s *SchedulesService) Create(ctx context.Context, schedule *Schedule) (*Schedule, error) {
+	if schedule == nil || schedule.TemplateID == "" {
+		return nil, NewValidationError("Template ID is required", nil)
+	}
+	if schedule.Cron == "" {
+		return nil, NewValidationError("Cron expression is required", nil)
+	}
+
+	var result Schedule
+	if err := s.client.doJSON(ctx, "POST", "/api/v1/schedules", schedule, &result); err != nil {
+		return nil, err
+	}
+
+	return &result, nil
+}
+
+// Update replaces the configuration of an existing schedule.
+func (s *SchedulesService) Update(ctx context.Context, scheduleID string, schedule *Schedule) (*Schedule, error) {
+	if scheduleID == "" {
+		return nil, NewValidationError("Schedule ID is required", nil)
+	}
+
+	var result Schedule
+	endpoint := fmt.Sprintf("/api/v1/schedules/%s", url.PathEscape(scheduleID))
+	if err := s.client.doJSON(ctx, "PUT", endpoint, schedule, &result); err != nil {
+		return nil, err
+	}
+
+	return &result, nil
+}
+
+// Delete deletes a schedule.
+func (s *SchedulesService) Delete(ctx context.Context, scheduleID string) error {
+	if scheduleID == "" {
+		return NewValidationError("Schedule ID is required", nil)
+	}
+
+	endpoint := fmt.Sprintf("/api/v1/schedules/%s", url.PathEscape(scheduleID))
+	return s.client.doJSON(ctx, "DELETE", endpoint, nil, nil)
+}
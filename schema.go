@@ -0,0 +1,64 @@
+package documentstack
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+)
+
+// TemplateSchema describes the data and flags a template accepts.
+type TemplateSchema struct {
+	// Variables are the data variables referenced by the template.
+	Variables []TemplateVariable `json:"variables"`
+
+	// Flags are the conditional-content flags the template understands.
+	Flags []TemplateFlag `json:"flags"`
+
+	// Deprecation is set if the template is deprecated.
+	Deprecation *Deprecation `json:"deprecation,omitempty"`
+}
+
+// TemplateVariable describes a data variable referenced by a template.
+type TemplateVariable struct {
+	// Path is the variable path, e.g. "customer.address.street".
+	Path string `json:"path"`
+
+	// Type is the expected JSON type: "string", "number", "boolean", "object" or "array".
+	Type string `json:"type"`
+
+	// Required reports whether the template requires the variable.
+	Required bool `json:"required"`
+
+	// Description is an optional description of the variable.
+	Description string `json:"description,omitempty"`
+
+	// Deprecation is set if the variable is deprecated.
+	Deprecation *Deprecation `json:"deprecation,omitempty"`
+}
+
+// TemplateFlag describes a conditional-content flag, see GenerateRequest.Flags.
+type TemplateFlag struct {
+	// Name is the flag name, e.g. "include_terms".
+	Name string `json:"name"`
+
+	// Default is the value used when the flag is not set.
+	Default bool `json:"default"`
+
+	// Description is an optional description of the flag.
+	Description string `json:"description,omitempty"`
+}
+
+// Schema returns the variables and flags the template accepts.
+func (s *TemplatesService) Schema(ctx context.Context, templateID string) (*TemplateSchema, error) {
+	if templateID == "" {
+		return nil, NewValidationError("Template ID is required", nil)
+	}
+
+	var result TemplateSchema
+	endpoint := fmt.Sprintf("/api/v1/templates/%s/schema", url.PathEscape(templateID))
+	if err := s.client.doJSON(ctx, "GET", endpoint, nil, &result); err != nil {
+		return nil, err
+	}
+
+	return &result, nil
+}
@@ -0,0 +1,175 @@
+package documentstack
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+const defaultSegmentSize = 16 << 20
+
+// SegmentedDownloadOptions controls a parallel ranged download.
+type SegmentedDownloadOptions struct {
+	// SegmentSize is the size in bytes of each ranged request.
+	// Default: 16 MiB
+	SegmentSize int64
+
+	// Concurrency is the number of segments downloaded in parallel.
+	// Default: 4
+	Concurrency int
+}
+
+// downloadSegmented downloads the content at path into w in parallel byte
+// range requests and returns its size. Each segment is retried and timed out
+// on its own (see Timeouts.Download). If the server does not support range
+// requests, the content is downloaded in a single request.
+func (c *Client) downloadSegmented(ctx context.Context, path string, w io.WriterAt, opts *SegmentedDownloadOptions) (int64, error) {
+	ctx = withOperation(ctx, operationDownload)
+
+	segmentSize := int64(defaultSegmentSize)
+	concurrency := defaultBatchConcurrency
+	if opts != nil && opts.SegmentSize > 0 {
+		segmentSize = opts.SegmentSize
+	}
+	if opts != nil && opts.Concurrency > 0 {
+		concurrency = opts.Concurrency
+	}
+
+	// The first segment doubles as the probe for range support and size.
+	resp, err := c.getRange(ctx, path, 0, segmentSize)
+	if err != nil {
+		return 0, err
+	}
+
+	if resp.StatusCode != http.StatusPartialContent {
+		defer resp.Body.Close()
+		n, err := io.Copy(io.NewOffsetWriter(w, 0), resp.Body)
+		if err != nil {
+			return n, &NetworkError{Message: "failed to read response body", Cause: err}
+		}
+		return n, nil
+	}
+
+	total, err := contentRangeTotal(resp.Header.Get("Content-Range"))
+	if err != nil {
+		resp.Body.Close()
+		return 0, err
+	}
+
+	if err := writeSegment(resp, w, 0, min(segmentSize, total)); err != nil {
+		return 0, err
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	work := make(chan int64)
+	var wg sync.WaitGroup
+	var once sync.Once
+	var firstErr error
+
+	for i := 0; i < concurrency; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for start := range work {
+				length := min(segmentSize, total-start)
+				err := c.downloadSegment(ctx, path, w, start, length)
+				if err != nil {
+					once.Do(func() {
+						firstErr = err
+						cancel()
+					})
+				}
+			}
+		}()
+	}
+
+dispatch:
+	for start := segmentSize; start < total; start += segmentSize {
+		select {
+		case work <- start:
+		case <-ctx.Done():
+			break dispatch
+		}
+	}
+	close(work)
+	wg.Wait()
+
+	if firstErr != nil {
+		return 0, firstErr
+	}
+	if err := ctx.Err(); err != nil {
+		return 0, err
+	}
+
+	return total, nil
+}
+
+// downloadSegment downloads length bytes at start into w.
+func (c *Client) downloadSegment(ctx context.Context, path string, w io.WriterAt, start, length int64) error {
+	resp, err := c.getRange(ctx, path, start, length)
+	if err != nil {
+		return err
+	}
+
+	if resp.StatusCode != http.StatusPartialContent {
+		resp.Body.Close()
+		return &DocumentStackError{Message: fmt.Sprintf("range request for %s returned HTTP %d instead of 206", path, resp.StatusCode)}
+	}
+
+	return writeSegment(resp, w, start, length)
+}
+
+// getRange requests length bytes at start of the content at path.
+func (c *Client) getRange(ctx context.Context, path string, start, length int64) (*http.Response, error) {
+	req, err := c.newRequest(ctx, "GET", path, nil, "")
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Range", fmt.Sprintf("bytes=%d-%d", start, start+length-1))
+	// Ranges refer to the stored bytes, so the body must not be re-encoded.
+	req.Header.Set("Accept-Encoding", "identity")
+
+	return c.send(ctx, req)
+}
+
+// writeSegment copies the body of the ranged response resp, which must hold
+// exactly length bytes at start, into w and closes it.
+func writeSegment(resp *http.Response, w io.WriterAt, start, length int64) error {
+	defer resp.Body.Close()
+
+	if got := resp.Header.Get("Content-Range"); !strings.HasPrefix(got, fmt.Sprintf("bytes %d-", start)) {
+		return &DocumentStackError{Message: fmt.Sprintf("unexpected Content-Range %q for segment at %d", got, start)}
+	}
+
+	n, err := io.Copy(io.NewOffsetWriter(w, start), io.LimitReader(resp.Body, length))
+	if err != nil {
+		return &NetworkError{Message: "failed to read response body", Cause: err}
+	}
+	if n != length {
+		return &NetworkError{Message: fmt.Sprintf("segment at %d truncated: got %d of %d bytes", start, n, length)}
+	}
+
+	return nil
+}
+
+// contentRangeTotal returns the complete length from a Content-Range header
+// such as "bytes 0-1023/4096".
+func contentRangeTotal(contentRange string) (int64, error) {
+	slash := strings.LastIndexByte(contentRange, '/')
+	if slash < 0 {
+		return 0, &DocumentStackError{Message: fmt.Sprintf("invalid Content-Range %q", contentRange)}
+	}
+
+	total, err := strconv.ParseInt(contentRange[slash+1:], 10, 64)
+	if err != nil || total <= 0 {
+		return 0, &DocumentStackError{Message: fmt.Sprintf("invalid Content-Range %q", contentRange)}
+	}
+
+	return total, nil
+}
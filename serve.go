@@ -0,0 +1,116 @@
+package documentstack
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"io"
+	"mime"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+const defaultCacheControl = "private, no-cache"
+
+// ServeOptions controls how a generated PDF is written to an http.ResponseWriter.
+type ServeOptions struct {
+	// Filename overrides the filename sent in Content-Disposition.
+	// Default: the filename returned by the API.
+	Filename string
+
+	// Inline displays the PDF in the browser instead of downloading it.
+	Inline bool
+
+	// CacheControl is the Cache-Control header value.
+	// Default: "private, no-cache"
+	CacheControl string
+}
+
+// ServePDF writes result to w as a PDF download. Range, If-Range and
+// conditional requests are supported via http.ServeContent, with an ETag
+// derived from the PDF content.
+//
+// Example:
+//
+//	result, err := client.Generate(r.Context(), "invoice", request)
+//	if err != nil {
+//		http.Error(w, "failed to generate invoice", http.StatusBadGateway)
+//		return
+//	}
+//	documentstack.ServePDF(w, r, result, nil)
+func ServePDF(w http.ResponseWriter, r *http.Request, result *GenerateResponse, opts *ServeOptions) {
+	setServeHeaders(w, result.Filename, opts)
+
+	sum := sha256.Sum256(result.PDF)
+	w.Header().Set("ETag", `"`+hex.EncodeToString(sum[:16])+`"`)
+
+	http.ServeContent(w, r, "", time.Time{}, bytes.NewReader(result.PDF))
+}
+
+// ServeStream copies stream to w as a PDF download and closes it. Range
+// requests are not supported since the stream cannot seek; Accept-Ranges is
+// set to "none". Errors are returned after the headers have been written, so
+// the caller can only log them.
+func ServeStream(w http.ResponseWriter, r *http.Request, stream *StreamResponse, opts *ServeOptions) error {
+	defer stream.Body.Close()
+
+	setServeHeaders(w, stream.Filename, opts)
+	w.Header().Set("Accept-Ranges", "none")
+	if stream.ContentLength > 0 {
+		w.Header().Set("Content-Length", strconv.FormatInt(stream.ContentLength, 10))
+	}
+
+	w.WriteHeader(http.StatusOK)
+	if r.Method == http.MethodHead {
+		return nil
+	}
+
+	if _, err := io.Copy(w, stream.Body); err != nil {
+		return &NetworkError{Message: "failed to write PDF", Cause: err}
+	}
+
+	return nil
+}
+
+// ServeGenerate generates a PDF and streams it to w in one call. If generation
+// fails, nothing is written to w and the error is returned so the caller can
+// render its own error page.
+func (c *Client) ServeGenerate(ctx context.Context, w http.ResponseWriter, r *http.Request, templateID string, request *GenerateRequest, opts *ServeOptions) error {
+	stream, err := c.GenerateStream(ctx, templateID, request)
+	if err != nil {
+		return err
+	}
+
+	return ServeStream(w, r, stream, opts)
+}
+
+func setServeHeaders(w http.ResponseWriter, filename string, opts *ServeOptions) {
+	if opts == nil {
+		opts = &ServeOptions{}
+	}
+
+	if opts.Filename != "" {
+		filename = opts.Filename
+	}
+	if filename == "" {
+		filename = "document.pdf"
+	}
+
+	disposition := "attachment"
+	if opts.Inline {
+		disposition = "inline"
+	}
+
+	cacheControl := opts.CacheControl
+	if cacheControl == "" {
+		cacheControl = defaultCacheControl
+	}
+
+	header := w.Header()
+	header.Set("Content-Type", "application/pdf")
+	header.Set("Content-Disposition", mime.FormatMediaType(disposition, map[string]string{"filename": filename}))
+	header.Set("Cache-Control", cacheControl)
+	header.Set("X-Content-Type-Options", "nosniff")
+}
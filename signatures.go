@@ -0,0 +1,266 @@
+package documentstack
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"time"
+)
+
+const defaultEnvelopePollInterval = 30 * time.Second
+
+// EnvelopeStatus is the state of a signature envelope.
+type EnvelopeStatus string
+
+// Envelope statuses.
+const (
+	EnvelopeSent      EnvelopeStatus = "sent"
+	EnvelopeCompleted EnvelopeStatus = "completed"
+	EnvelopeDeclined  EnvelopeStatus = "declined"
+	EnvelopeVoided    EnvelopeStatus = "voided"
+	EnvelopeExpired   EnvelopeStatus = "expired"
+)
+
+// SignatureFieldType is the kind of field a signer fills in.
+type SignatureFieldType string
+
+// Signature field types.
+const (
+	FieldSignature SignatureFieldType = "signature"
+	FieldInitials  SignatureFieldType = "initials"
+	FieldDate      SignatureFieldType = "date"
+	FieldText      SignatureFieldType = "text"
+)
+
+// SignaturesService sends documents for e-signature and retrieves the signed result.
+type SignaturesService struct {
+	client *Client
+}
+
+// Signer is a person asked to sign an envelope.
+type Signer struct {
+	Name  string `json:"name"`
+	Email string `json:"email"`
+
+	// Order is the signing order, starting at 1. Signers with the same order
+	// sign in parallel. Default: all signers sign in parallel.
+	Order int `json:"order,omitempty"`
+
+	// Status is the signer's progress, e.g. "pending", "viewed" or "signed". Set by the API.
+	Status string `json:"status,omitempty"`
+
+	// SignedAt is the time the signer signed. Set by the API.
+	SignedAt *time.Time `json:"signedAt,omitempty"`
+}
+
+// SignatureField is a field placed on the document for a signer. Place it
+// either by Page and coordinates or by Anchor text.
+type SignatureField struct {
+	Type SignatureFieldType `json:"type"`
+
+	// Signer is the email of the signer who fills in the field.
+	Signer string `json:"signer"`
+
+	// Page is the 1-based page number.
+	Page int `json:"page,omitempty"`
+
+	// Box is the field position on the page.
+	Box *Rect `json:"box,omitempty"`
+
+	// Anchor places the field on the first occurrence of this text instead of Page and Box.
+	Anchor string `json:"anchor,omitempty"`
+
+	// Required reports whether the signer must fill in the field.
+	Required bool `json:"required,omitempty"`
+}
+
+// EnvelopeRequest describes a document to send for signature.
+type EnvelopeRequest struct {
+	// Source is the document to sign.
+	Source *Source `json:"source"`
+
+	// Subject is the subject of the signing request email.
+	Subject string `json:"subject,omitempty"`
+
+	// Message is the body of the signing request email.
+	Message string `json:"message,omitempty"`
+
+	Signers []Signer         `json:"signers"`
+	Fields  []SignatureField `json:"fields,omitempty"`
+
+	// ExpiresIn is how long signers have to sign.
+	ExpiresIn time.Duration `json:"-"`
+}
+
+// Envelope is a document sent for signature.
+type Envelope struct {
+	ID      string         `json:"id"`
+	Status  EnvelopeStatus `json:"status"`
+	Subject string         `json:"subject,omitempty"`
+	Signers []Signer       `json:"signers"`
+
+	// CompletedAt is the time the last signer signed.
+	CompletedAt *time.Time `json:"completedAt,omitempty"`
+
+	// ExpiresAt is the time the envelope expires if not completed.
+	ExpiresAt *time.Time `json:"expiresAt,omitempty"`
+
+	CreatedAt time.Time `json:"createdAt"`
+}
+
+// Done reports whether the envelope has reached a final status: completed,
+// declined, voided or expired.
+func (e *Envelope) Done() bool {
+	switch e.Status {
+	case EnvelopeCompleted, EnvelopeDeclined, EnvelopeVoided, EnvelopeExpired:
+		return true
+	}
+	return false
+}
+
+// EnvelopeList is a page of envelopes.
+type EnvelopeList struct {
+	Envelopes  []*Envelope `json:"envelopes"`
+	NextCursor string      `json:"nextCursor,omitempty"`
+}
+
+// Send sends a document for signature.
+//
+// Example:
+//
+//	envelope, err := client.Signatures.Send(ctx, &documentstack.EnvelopeRequest{
+//		Source:  documentstack.SourceFromBytes(contract.PDF),
+//		Subject: "Please sign your contract",
+//		Signers: []documentstack.Signer{{Name: "Jane Doe", Email: "jane@example.com"}},
+//		Fields: []documentstack.SignatureField{
+//			{Type: documentstack.FieldSignature, Signer: "jane@example.com", Anchor: "Signature:"},
+//		},
+//	})
+func (s *SignaturesService) Send(ctx context.Context, request *EnvelopeRequest) (*Envelope, error) {
+	if request == nil {
+		return nil, NewValidationError("Envelope request is required", nil)
+	}
+	if err := request.Source.validate(); err != nil {
+		return nil, err
+	}
+	if len(request.Signers) == 0 {
+		return nil, NewValidationError("At least one signer is required", nil)
+	}
+	if err := s.client.requireFeature(ctx, FeatureSigning); err != nil {
+		return nil, err
+	}
+
+	body := struct {
+		*EnvelopeRequest
+		ExpiresInSeconds int64 `json:"expiresInSeconds,omitempty"`
+	}{
+		EnvelopeRequest:  request,
+		ExpiresInSeconds: int64(request.ExpiresIn / time.Second),
+	}
+
+	var result Envelope
+	if err := s.client.doJSON(ctx, "POST", "/api/v1/signatures/envelopes", body, &result); err != nil {
+		return nil, err
+	}
+
+	return &result, nil
+}
+
+// Get retrieves an envelope, including its status and each signer's progress.
+func (s *SignaturesService) Get(ctx context.Context, envelopeID string) (*Envelope, error) {
+	if envelopeID == "" {
+		return nil, NewValidationError("Envelope ID is required", nil)
+	}
+
+	var result Envelope
+	endpoint := fmt.Sprintf("/api/v1/signatures/envelopes/%s", url.PathEscape(envelopeID))
+	if err := s.client.doJSON(ctx, "GET", endpoint, nil, &result); err != nil {
+		return nil, err
+	}
+
+	return &result, nil
+}
+
+// Wait polls an envelope every interval until it is Done, and returns it.
+// It returns ctx's error if ctx is done first; bound the wait with a ctx
+// deadline, as signers may take days.
+// Default interval: 30s
+//
+// Example:
+//
+//	ctx, cancel := context.WithTimeout(ctx, 24*time.Hour)
+//	defer cancel()
+//	envelope, err := client.Signatures.Wait(ctx, envelope.ID, time.Minute)
+//	if err == nil && envelope.Status == documentstack.EnvelopeCompleted {
+//		signed, err := client.Signatures.Download(ctx, envelope.ID)
+//		...
+//	}
+func (s *SignaturesService) Wait(ctx context.Context, envelopeID string, interval time.Duration) (*Envelope, error) {
+	if interval <= 0 {
+		interval = defaultEnvelopePollInterval
+	}
+
+	for {
+		envelope, err := s.Get(ctx, envelopeID)
+		if err != nil {
+			return nil, err
+		}
+		if envelope.Done() {
+			return envelope, nil
+		}
+
+		timer := time.NewTimer(interval)
+		select {
+		case <-timer.C:
+		case <-ctx.Done():
+			timer.Stop()
+			return nil, ctx.Err()
+		}
+	}
+}
+
+// List returns a page of envelopes, most recent first.
+func (s *SignaturesService) List(ctx context.Context, opts *ListOptions) (*EnvelopeList, error) {
+	var result EnvelopeList
+	if err := s.client.doJSON(ctx, "GET", listPath("/api/v1/signatures/envelopes", opts), nil, &result); err != nil {
+		return nil, err
+	}
+
+	return &result, nil
+}
+
+// ListAll returns all envelopes, fetching every page. See ListAllOptions.
+func (s *SignaturesService) ListAll(ctx context.Context, opts *ListAllOptions) ([]*Envelope, error) {
+	return listAll(ctx, opts, func(page *ListOptions) ([]*Envelope, string, error) {
+		result, err := s.List(ctx, page)
+		if err != nil {
+			return nil, "", err
+		}
+		return result.Envelopes, result.NextCursor, nil
+	})
+}
+
+// Void cancels an envelope that has not been completed.
+func (s *SignaturesService) Void(ctx context.Context, envelopeID, reason string) (*Envelope, error) {
+	if envelopeID == "" {
+		return nil, NewValidationError("Envelope ID is required", nil)
+	}
+
+	var result Envelope
+	endpoint := fmt.Sprintf("/api/v1/signatures/envelopes/%s/void", url.PathEscape(envelopeID))
+	if err := s.client.doJSON(ctx, "POST", endpoint, map[string]string{"reason": reason}, &result); err != nil {
+		return nil, err
+	}
+
+	return &result, nil
+}
+
+// Download returns the signed PDF of a completed envelope.
+func (s *SignaturesService) Download(ctx context.Context, envelopeID string) (*GenerateResponse, error) {
+	if envelopeID == "" {
+		return nil, NewValidationError("Envelope ID is required", nil)
+	}
+
+	endpoint := fmt.Sprintf("/api/v1/signatures/envelopes/%s/document", url.PathEscape(envelopeID))
+	return s.client.getPDF(ctx, endpoint)
+}
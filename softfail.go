@@ -0,0 +1,114 @@
+package documentstack
+
+import (
+	"context"
+	"errors"
+	"log"
+)
+
+// SoftFailConfig configures the placeholder PDF returned when generation fails.
+//
+// When soft-fail applies, Generate returns both a non-nil *GenerateResponse
+// with Fallback set and the original error. Callers that return on any error
+// keep the usual behavior; callers that want to degrade gracefully check the
+// response first:
+//
+//	result, err := client.Generate(ctx, "invoice", request)
+//	if result != nil && result.Fallback {
+//		log.Printf("serving placeholder invoice: %v", err)
+//	} else if err != nil {
+//		return err
+//	}
+type SoftFailConfig struct {
+	// PDF is the placeholder document, e.g. "Your invoice is being prepared".
+	PDF []byte
+
+	// Filename is the placeholder's filename.
+	// Default: "document.pdf"
+	Filename string
+
+	// ShouldFallback decides which errors return the placeholder.
+	// Default: IsTransient
+	ShouldFallback func(err error) bool
+}
+
+// IsTransient reports whether err is caused by an outage rather than by the
+// request: network errors, timeouts, rate limiting and 5xx server errors.
+func IsTransient(err error) bool {
+	var netErr *NetworkError
+	var timeoutErr *TimeoutError
+	var rlErr *RateLimitError
+	var apiErr *APIError
+
+	switch {
+	case errors.As(err, &netErr), errors.As(err, &timeoutErr), errors.As(err, &rlErr):
+		return true
+	case errors.As(err, &apiErr):
+		return apiErr.IsServerError()
+	}
+	return false
+}
+
+// FallbackRenderer renders documents locally when the API is unavailable,
+// e.g. through a headless browser, so critical documents can still be
+// delivered during a prolonged outage.
+type FallbackRenderer interface {
+	Render(ctx context.Context, templateID string, request *GenerateRequest) (*GenerateResponse, error)
+}
+
+// FallbackRendererFunc adapts a function to the FallbackRenderer interface.
+type FallbackRendererFunc func(ctx context.Context, templateID string, request *GenerateRequest) (*GenerateResponse, error)
+
+// Render calls f.
+func (f FallbackRendererFunc) Render(ctx context.Context, templateID string, request *GenerateRequest) (*GenerateResponse, error) {
+	return f(ctx, templateID, request)
+}
+
+// fallback handles a failed generation: transient errors are first handed to
+// Config.FallbackRenderer, and if that is not configured or fails, to soft-fail.
+func (c *Client) fallback(ctx context.Context, templateID string, request *GenerateRequest, err error) (*GenerateResponse, error) {
+	if c.config.FallbackRenderer != nil && IsTransient(err) && ctx.Err() == nil {
+		response, renderErr := c.config.FallbackRenderer.Render(ctx, templateID, request)
+		if renderErr == nil && response != nil {
+			if c.config.Debug {
+				log.Printf("[DocumentStack] Rendered %s with fallback renderer after error: %v\n", templateID, err)
+			}
+			response.Fallback = true
+			return response, nil
+		}
+
+		if c.config.Debug {
+			log.Printf("[DocumentStack] Fallback renderer failed: %v\n", renderErr)
+		}
+	}
+
+	return c.softFail(err)
+}
+
+// softFail returns the placeholder response along with err if Config.SoftFail applies.
+func (c *Client) softFail(err error) (*GenerateResponse, error) {
+	config := c.config.SoftFail
+	if config == nil || len(config.PDF) == 0 {
+		return nil, err
+	}
+
+	shouldFallback := config.ShouldFallback
+	if shouldFallback == nil {
+		shouldFallback = IsTransient
+	}
+	if !shouldFallback(err) {
+		return nil, err
+	}
+
+	filename := config.Filename
+	if filename == "" {
+		filename = "document.pdf"
+	}
+
+	return &GenerateResponse{
+		PDF:           config.PDF,
+		Filename:      filename,
+		ContentLength: int64(len(config.PDF)),
+		Fallback:      true,
+	}, err
+}
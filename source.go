@@ -0,0 +1,53 @@
+package documentstack
+
+// Source identifies an existing document for operations such as Stamp.
+// Exactly one field must be set; use the Source* constructors.
+type Source struct {
+	// DocumentID is the ID of a document stored in DocumentStack.
+	DocumentID string `json:"documentId,omitempty"`
+
+	// URL is a publicly reachable URL the API downloads the document from.
+	URL string `json:"url,omitempty"`
+
+	// Data is the document content, sent inline.
+	Data []byte `json:"data,omitempty"`
+}
+
+// SourceFromDocument returns a Source for a stored document.
+func SourceFromDocument(documentID string) *Source {
+	return &Source{DocumentID: documentID}
+}
+
+// SourceFromURL returns a Source the API downloads from url.
+func SourceFromURL(url string) *Source {
+	return &Source{URL: url}
+}
+
+// SourceFromBytes returns a Source for in-memory document content, such as
+// GenerateResponse.PDF.
+func SourceFromBytes(data []byte) *Source {
+	return &Source{Data: data}
+}
+
+// validate checks that exactly one field is set.
+func (s *Source) validate() error {
+	if s == nil {
+		return NewValidationError("Source is required", nil)
+	}
+
+	set := 0
+	if s.DocumentID != "" {
+		set++
+	}
+	if s.URL != "" {
+		set++
+	}
+	if len(s.Data) > 0 {
+		set++
+	}
+
+	if set != 1 {
+		return NewValidationError("Source must set exactly one of DocumentID, URL or Data", nil)
+	}
+	return nil
+}
@@ -0,0 +1,227 @@
+package documentstack
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"io/fs"
+	"sort"
+	"strings"
+)
+
+// SyncAction is the change Sync makes to a single template.
+type SyncAction string
+
+const (
+	// SyncCreate creates a template that only exists locally.
+	SyncCreate SyncAction = "create"
+
+	// SyncUpdate updates a remote template that differs from its local definition.
+	SyncUpdate SyncAction = "update"
+
+	// SyncDelete deletes a remote template that has no local definition. Only planned with Prune.
+	SyncDelete SyncAction = "delete"
+)
+
+// SyncOptions controls how Sync converges the workspace.
+type SyncOptions struct {
+	// Prune deletes remote templates that have no local definition.
+	Prune bool
+
+	// DryRun computes the plan without applying it.
+	DryRun bool
+
+	// Output, if set, receives the plan before it is applied.
+	Output io.Writer
+}
+
+// SyncChange is a single planned change.
+type SyncChange struct {
+	Action     SyncAction
+	TemplateID string
+}
+
+// SyncPlan is the set of changes needed to converge the workspace with the
+// local templates.
+type SyncPlan struct {
+	// Changes are the planned changes, ordered by template ID.
+	Changes []SyncChange
+
+	// Unchanged are the IDs of templates that already match their local definition.
+	Unchanged []string
+}
+
+// String formats the plan as one line per change followed by a summary.
+func (p *SyncPlan) String() string {
+	var b strings.Builder
+	counts := make(map[SyncAction]int)
+
+	for _, change := range p.Changes {
+		symbol := "~"
+		switch change.Action {
+		case SyncCreate:
+			symbol = "+"
+		case SyncDelete:
+			symbol = "-"
+		}
+		fmt.Fprintf(&b, "%s %s\n", symbol, change.TemplateID)
+		counts[change.Action]++
+	}
+
+	fmt.Fprintf(&b, "Plan: %d to create, %d to update, %d to delete, %d unchanged.\n",
+		counts[SyncCreate], counts[SyncUpdate], counts[SyncDelete], len(p.Unchanged))
+
+	return b.String()
+}
+
+// Sync converges the remote workspace with the template files in dir of fsys.
+// Templates are read as described in ReadTemplatesFS; use os.DirFS to sync
+// from a directory on disk.
+//
+// Sync returns the plan it computed. When an error occurs while applying the
+// plan, the changes before the failing one have already been applied.
+//
+// Example:
+//
+//	plan, err := client.Templates.Sync(ctx, os.DirFS("templates"), ".", &documentstack.SyncOptions{
+//		Prune:  true,
+//		DryRun: true,
+//		Output: os.Stdout,
+//	})
+func (s *TemplatesService) Sync(ctx context.Context, fsys fs.FS, dir string, opts *SyncOptions) (*SyncPlan, error) {
+	if opts == nil {
+		opts = &SyncOptions{}
+	}
+
+	local, err := ReadTemplatesFS(fsys, dir)
+	if err != nil {
+		return nil, err
+	}
+
+	plan, err := s.plan(ctx, local, opts.Prune)
+	if err != nil {
+		return nil, err
+	}
+
+	if opts.Output != nil {
+		fmt.Fprint(opts.Output, plan.String())
+	}
+
+	if opts.DryRun {
+		return plan, nil
+	}
+
+	return plan, s.apply(ctx, plan, local)
+}
+
+// plan computes the changes needed to converge the workspace with local.
+func (s *TemplatesService) plan(ctx context.Context, local []*Template, prune bool) (*SyncPlan, error) {
+	remote, err := s.listAll(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	plan := &SyncPlan{}
+	localIDs := make(map[string]bool, len(local))
+
+	for _, template := range local {
+		localIDs[template.ID] = true
+
+		if _, ok := remote[template.ID]; !ok {
+			plan.Changes = append(plan.Changes, SyncChange{Action: SyncCreate, TemplateID: template.ID})
+			continue
+		}
+
+		changed, err := s.changed(ctx, remote[template.ID], template)
+		if err != nil {
+			return nil, err
+		}
+
+		if changed {
+			plan.Changes = append(plan.Changes, SyncChange{Action: SyncUpdate, TemplateID: template.ID})
+		} else {
+			plan.Unchanged = append(plan.Unchanged, template.ID)
+		}
+	}
+
+	if prune {
+		for id := range remote {
+			if !localIDs[id] {
+				plan.Changes = append(plan.Changes, SyncChange{Action: SyncDelete, TemplateID: id})
+			}
+		}
+	}
+
+	sort.Slice(plan.Changes, func(i, j int) bool {
+		return plan.Changes[i].TemplateID < plan.Changes[j].TemplateID
+	})
+	sort.Strings(plan.Unchanged)
+
+	return plan, nil
+}
+
+// changed reports whether the remote template listed as current differs from
+// its local definition. Content is compared by checksum, so the template is
+// only fetched if the API did not list its checksum.
+func (s *TemplatesService) changed(ctx context.Context, current, local *Template) (bool, error) {
+	if current.Name != local.Name || current.Description != local.Description {
+		return true, nil
+	}
+
+	if current.ContentChecksum != "" {
+		sum := sha256.Sum256([]byte(local.Content))
+		return current.ContentChecksum != hex.EncodeToString(sum[:]), nil
+	}
+
+	full, err := s.Get(ctx, local.ID)
+	if err != nil {
+		return false, err
+	}
+	return full.Content != local.Content, nil
+}
+
+// apply executes plan, pushing templates from local.
+func (s *TemplatesService) apply(ctx context.Context, plan *SyncPlan, local []*Template) error {
+	localByID := make(map[string]*Template, len(local))
+	for _, template := range local {
+		localByID[template.ID] = template
+	}
+
+	for _, change := range plan.Changes {
+		var err error
+		switch change.Action {
+		case SyncCreate, SyncUpdate:
+			_, err = s.Push(ctx, localByID[change.TemplateID])
+		case SyncDelete:
+			err = s.Delete(ctx, change.TemplateID)
+		}
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// listAll returns every template in the workspace keyed by ID.
+func (s *TemplatesService) listAll(ctx context.Context) (map[string]*Template, error) {
+	templates, err := collectPages(func(opts *ListOptions) ([]*Template, string, error) {
+		page, err := s.List(ctx, opts)
+		if err != nil {
+			return nil, "", err
+		}
+		return page.Templates, page.NextCursor, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	byID := make(map[string]*Template, len(templates))
+	for _, template := range templates {
+		byID[template.ID] = template
+	}
+
+	return byID, nil
+}
@@ -0,0 +1,249 @@
+package documentstack
+
+import (
+	"context"
+	"fmt"
+	"io/fs"
+	"net/url"
+	"path"
+	"strings"
+	"time"
+)
+
+// TemplatesService manages templates in the workspace.
+type TemplatesService struct {
+	client *Client
+}
+
+// Template is a template stored in the workspace.
+type Template struct {
+	// ID is the unique template identifier.
+	ID string `json:"id"`
+
+	// Name is the human-readable template name.
+	Name string `json:"name"`
+
+	// Description is an optional description of the template.
+	Description string `json:"description,omitempty"`
+
+	// Content is the template source.
+	Content string `json:"content"`
+
+	// Partials are the IDs of the partials the template includes. Set by the API.
+	Partials []string `json:"partials,omitempty"`
+
+	// Version is incremented each time the template content changes. Set by the API.
+	Version int `json:"version,omitempty"`
+
+	// ContentChecksum is the hex-encoded SHA-256 of Content, also returned by
+	// List, which omits Content. Set by the API.
+	ContentChecksum string `json:"contentChecksum,omitempty"`
+
+	// CreatedAt is the time the template was created. Set by the API.
+	CreatedAt time.Time `json:"createdAt"`
+
+	// UpdatedAt is the time the template was last updated. Set by the API.
+	UpdatedAt time.Time `json:"updatedAt"`
+
+	// DeletedAt is the time the template was moved to the trash, and PurgeAt
+	// the time it will be permanently deleted, for templates in the trash.
+	// Set by the API.
+	DeletedAt *time.Time `json:"deletedAt,omitempty"`
+	PurgeAt   *time.Time `json:"purgeAt,omitempty"`
+}
+
+// Push creates the template or replaces its content if a template with the same
+// ID exists.
+func (s *TemplatesService) Push(ctx context.Context, template *Template) (*Template, error) {
+	if template == nil || template.ID == "" {
+		return nil, NewValidationError("Template ID is required", nil)
+	}
+
+	var result Template
+	endpoint := fmt.Sprintf("/api/v1/templates/%s", url.PathEscape(template.ID))
+	if err := s.client.doJSON(ctx, "PUT", endpoint, template, &result); err != nil {
+		return nil, err
+	}
+
+	return &result, nil
+}
+
+// PushFS pushes every template file in dir of fsys, such as an embed.FS.
+// See ReadTemplatesFS for how files map to templates.
+//
+// Example:
+//
+//	//go:embed templates
+//	var templates embed.FS
+//
+//	_, err := client.Templates.PushFS(ctx, templates, "templates")
+func (s *TemplatesService) PushFS(ctx context.Context, fsys fs.FS, dir string) ([]*Template, error) {
+	templates, err := ReadTemplatesFS(fsys, dir)
+	if err != nil {
+		return nil, err
+	}
+
+	pushed := make([]*Template, 0, len(templates))
+	for _, template := range templates {
+		result, err := s.Push(ctx, template)
+		if err != nil {
+			return pushed, err
+		}
+		pushed = append(pushed, result)
+	}
+
+	return pushed, nil
+}
+
+// ReadTemplatesFS reads the template files in dir of fsys. Each regular file
+// becomes one template whose ID and name are the file name without its
+// extension, and whose content is the file content. Subdirectories and hidden
+// files are skipped.
+func ReadTemplatesFS(fsys fs.FS, dir string) ([]*Template, error) {
+	entries, err := fs.ReadDir(fsys, dir)
+	if err != nil {
+		return nil, &DocumentStackError{Message: fmt.Sprintf("failed to read template directory %q: %v", dir, err)}
+	}
+
+	var templates []*Template
+	for _, entry := range entries {
+		if entry.IsDir() || strings.HasPrefix(entry.Name(), ".") {
+			continue
+		}
+
+		content, err := fs.ReadFile(fsys, path.Join(dir, entry.Name()))
+		if err != nil {
+			return nil, &DocumentStackError{Message: fmt.Sprintf("failed to read template %q: %v", entry.Name(), err)}
+		}
+
+		id := strings.TrimSuffix(entry.Name(), path.Ext(entry.Name()))
+		templates = append(templates, &Template{
+			ID:      id,
+			Name:    id,
+			Content: string(content),
+		})
+	}
+
+	return templates, nil
+}
+
+// TemplateList is a page of templates.
+type TemplateList struct {
+	// Templates are the templates on this page. Content is not included.
+	Templates []*Template `json:"templates"`
+
+	// NextCursor is the cursor for the next page, or empty if this is the last page.
+	NextCursor string `json:"nextCursor,omitempty"`
+}
+
+// Get retrieves a template including its content.
+func (s *TemplatesService) Get(ctx context.Context, templateID string) (*Template, error) {
+	if templateID == "" {
+		return nil, NewValidationError("Template ID is required", nil)
+	}
+
+	var result Template
+	endpoint := fmt.Sprintf("/api/v1/templates/%s", url.PathEscape(templateID))
+	if err := s.client.doJSON(ctx, "GET", endpoint, nil, &result); err != nil {
+		return nil, err
+	}
+
+	return &result, nil
+}
+
+// List returns a page of templates in the workspace.
+func (s *TemplatesService) List(ctx context.Context, opts *ListOptions) (*TemplateList, error) {
+	var result TemplateList
+	if err := s.client.doJSON(ctx, "GET", listPath("/api/v1/templates", opts), nil, &result); err != nil {
+		return nil, err
+	}
+
+	return &result, nil
+}
+
+// ListAll returns all templates, without content, fetching every page. See ListAllOptions.
+func (s *TemplatesService) ListAll(ctx context.Context, opts *ListAllOptions) ([]*Template, error) {
+	return listAll(ctx, opts, func(page *ListOptions) ([]*Template, string, error) {
+		result, err := s.List(ctx, page)
+		if err != nil {
+			return nil, "", err
+		}
+		return result.Templates, result.NextCursor, nil
+	})
+}
+
+// Delete moves a template to the trash. It can be restored with Restore
+// until it is purged, automatically after a retention period or with Purge.
+func (s *TemplatesService) Delete(ctx context.Context, templateID string) error {
+	if templateID == "" {
+		return NewValidationError("Template ID is required", nil)
+	}
+
+	endpoint := fmt.Sprintf("/api/v1/templates/%s", url.PathEscape(templateID))
+	return s.client.doJSON(ctx, "DELETE", endpoint, nil, nil)
+}
+
+// ListDeleted returns a page of templates in the trash.
+func (s *TemplatesService) ListDeleted(ctx context.Context, opts *ListOptions) (*TemplateList, error) {
+	var result TemplateList
+	if err := s.client.doJSON(ctx, "GET", listPath("/api/v1/templates/trash", opts), nil, &result); err != nil {
+		return nil, err
+	}
+
+	return &result, nil
+}
+
+// Restore moves a template out of the trash.
+func (s *TemplatesService) Restore(ctx context.Context, templateID string) (*Template, error) {
+	if templateID == "" {
+		return nil, NewValidationError("Template ID is required", nil)
+	}
+
+	var result Template
+	endpoint := fmt.Sprintf("/api/v1/templates/trash/%s/restore", url.PathEscape(templateID))
+	if err := s.client.doJSON(ctx, "POST", endpoint, nil, &result); err != nil {
+		return nil, err
+	}
+
+	return &result, nil
+}
+
+// Purge permanently deletes a template in the trash. It cannot be restored.
+func (s *TemplatesService) Purge(ctx context.Context, templateID string) error {
+	if templateID == "" {
+		return NewValidationError("Template ID is required", nil)
+	}
+
+	endpoint := fmt.Sprintf("/api/v1/templates/trash/%s", url.PathEscape(templateID))
+	return s.client.doJSON(ctx, "DELETE", endpoint, nil, nil)
+}
+
+// GetDefaults returns the template's default variable values.
+func (s *TemplatesService) GetDefaults(ctx context.Context, templateID string) (map[string]interface{}, error) {
+	if templateID == "" {
+		return nil, NewValidationError("Template ID is required", nil)
+	}
+
+	var result struct {
+		Defaults map[string]interface{} `json:"defaults"`
+	}
+	endpoint := fmt.Sprintf("/api/v1/templates/%s/defaults", url.PathEscape(templateID))
+	if err := s.client.doJSON(ctx, "GET", endpoint, nil, &result); err != nil {
+		return nil, err
+	}
+
+	return result.Defaults, nil
+}
+
+// SetDefaults replaces the template's default variable values. Defaults are
+// used for variables missing from the generation data; see
+// GenerateRequest.OverridesOnly.
+func (s *TemplatesService) SetDefaults(ctx context.Context, templateID string, defaults map[string]interface{}) error {
+	if templateID == "" {
+		return NewValidationError("Template ID is required", nil)
+	}
+
+	body := map[string]interface{}{"defaults": defaults}
+	endpoint := fmt.Sprintf("/api/v1/templates/%s/defaults", url.PathEscape(templateID))
+	return s.client.doJSON(ctx, "PUT", endpoint, body, nil)
+}
@@ -0,0 +1,85 @@
+package documentstack
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"time"
+)
+
+// StatsPeriod is the time window of template statistics, ending now.
+type StatsPeriod string
+
+// Statistics periods.
+const (
+	StatsLastDay     StatsPeriod = "1d"
+	StatsLast7Days   StatsPeriod = "7d"
+	StatsLast30Days  StatsPeriod = "30d"
+	StatsLast90Days  StatsPeriod = "90d"
+	StatsLast365Days StatsPeriod = "365d"
+)
+
+// TemplateStats are the generation statistics of a template over a period.
+type TemplateStats struct {
+	TemplateID string      `json:"templateId"`
+	Period     StatsPeriod `json:"period"`
+	From       time.Time   `json:"from"`
+	To         time.Time   `json:"to"`
+
+	// Generations is the number of generation attempts, including failed ones.
+	Generations int64 `json:"generations"`
+
+	// Errors is the number of failed generations.
+	Errors int64 `json:"errors"`
+
+	// AvgGenerationTimeMs is the average render time of successful
+	// generations, in milliseconds. P95GenerationTimeMs is its 95th percentile.
+	AvgGenerationTimeMs float64 `json:"avgGenerationTimeMs"`
+	P95GenerationTimeMs float64 `json:"p95GenerationTimeMs"`
+
+	// AvgSize is the average size of generated documents, in bytes.
+	AvgSize int64 `json:"avgSize"`
+
+	// LastGeneratedAt is the time of the most recent generation, if any.
+	LastGeneratedAt *time.Time `json:"lastGeneratedAt,omitempty"`
+}
+
+// ErrorRate returns the fraction of generations that failed, between 0 and 1.
+func (s *TemplateStats) ErrorRate() float64 {
+	if s.Generations == 0 {
+		return 0
+	}
+	return float64(s.Errors) / float64(s.Generations)
+}
+
+// Stats returns the generation statistics of a template over period, e.g.
+// to find unused templates or templates whose render time regressed.
+// Default period: StatsLast30Days
+//
+// Example:
+//
+//	stats, err := client.Templates.Stats(ctx, "invoice", documentstack.StatsLast7Days)
+//	if err == nil && stats.Generations == 0 {
+//		log.Printf("template %s is unused", stats.TemplateID)
+//	}
+func (s *TemplatesService) Stats(ctx context.Context, templateID string, period StatsPeriod) (*TemplateStats, error) {
+	if templateID == "" {
+		return nil, NewValidationError("Template ID is required", nil)
+	}
+
+	switch period {
+	case "":
+		period = StatsLast30Days
+	case StatsLastDay, StatsLast7Days, StatsLast30Days, StatsLast90Days, StatsLast365Days:
+	default:
+		return nil, NewValidationError(fmt.Sprintf("Invalid statistics period %q", period), nil)
+	}
+
+	var result TemplateStats
+	endpoint := fmt.Sprintf("/api/v1/templates/%s/stats?period=%s", url.PathEscape(templateID), url.QueryEscape(string(period)))
+	if err := s.client.doJSON(ctx, "GET", endpoint, nil, &result); err != nil {
+		return nil, err
+	}
+
+	return &result, nil
+}
@@ -0,0 +1,102 @@
+package documentstack
+
+import "context"
+
+// ThumbnailOptions controls how page thumbnails are rendered.
+type ThumbnailOptions struct {
+	// Pages selects the pages to render, e.g. "1-10" or "1,3,last".
+	// Default: all pages
+	Pages string `json:"pages,omitempty"`
+
+	// Width is the thumbnail width in pixels. The height follows the page's
+	// aspect ratio.
+	// Default: 200
+	Width int `json:"width,omitempty"`
+
+	// Format is the image format.
+	// Default: ImagePNG
+	Format ImageFormat `json:"format,omitempty"`
+
+	// Quality is the JPEG/WebP quality between 1 and 100.
+	Quality int `json:"quality,omitempty"`
+
+	// Sprite combines the thumbnails into a single sprite sheet image, so a
+	// page strip loads with one request instead of one per page.
+	Sprite bool `json:"sprite,omitempty"`
+
+	// Columns is the number of thumbnails per row of the sprite sheet.
+	// Default: all thumbnails in one row
+	Columns int `json:"columns,omitempty"`
+}
+
+// SpriteTile is the position of a page thumbnail within a SpriteSheet.
+type SpriteTile struct {
+	// Page is the 1-based page number.
+	Page int `json:"page"`
+
+	// X and Y are the offset of the tile's top-left corner in pixels.
+	X int `json:"x"`
+	Y int `json:"y"`
+
+	// Width and Height are the tile size in pixels.
+	Width  int `json:"width"`
+	Height int `json:"height"`
+}
+
+// SpriteSheet is a single image holding the thumbnails of several pages.
+type SpriteSheet struct {
+	// Width and Height are the sheet size in pixels.
+	Width  int `json:"width"`
+	Height int `json:"height"`
+
+	// ContentType is the MIME type of Data.
+	ContentType string `json:"contentType"`
+
+	// Data is the image content.
+	Data []byte `json:"data"`
+
+	// Tiles locate each page in the sheet, in page order, e.g. for CSS
+	// background-position.
+	Tiles []SpriteTile `json:"tiles"`
+}
+
+// ThumbnailResult holds the rendered thumbnails. Pages is set unless
+// ThumbnailOptions.Sprite is set, in which case Sprite is.
+type ThumbnailResult struct {
+	Pages  []PageImage  `json:"pages,omitempty"`
+	Sprite *SpriteSheet `json:"sprite,omitempty"`
+}
+
+// Thumbnails renders small images of the pages of a document, one per page
+// or combined into a sprite sheet, for page strips in document viewers. Use
+// Rasterize for full-resolution page images. opts may be nil.
+//
+// Example:
+//
+//	result, err := client.Thumbnails(ctx, documentstack.SourceFromDocument(id), &documentstack.ThumbnailOptions{
+//		Width:  120,
+//		Sprite: true,
+//	})
+//	for _, tile := range result.Sprite.Tiles {
+//		style := fmt.Sprintf("background-position: -%dpx -%dpx", tile.X, tile.Y)
+//	}
+func (c *Client) Thumbnails(ctx context.Context, source *Source, opts *ThumbnailOptions) (*ThumbnailResult, error) {
+	if err := source.validate(); err != nil {
+		return nil, err
+	}
+	if opts != nil && (opts.Width < 0 || opts.Columns < 0) {
+		return nil, NewValidationError("Thumbnail width and columns must not be negative", nil)
+	}
+
+	body := map[string]interface{}{
+		"source":  source,
+		"options": opts,
+	}
+
+	var result ThumbnailResult
+	if err := c.queryJSON(ctx, "/api/v1/thumbnails", body, &result); err != nil {
+		return nil, err
+	}
+
+	return &result, nil
+}
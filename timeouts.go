@@ -0,0 +1,122 @@
+package documentstack
+
+import (
+	"context"
+	"math"
+	"net"
+	"net/http"
+	"reflect"
+	"time"
+)
+
+// Timeouts sets per-attempt timeouts by kind of operation, so that fast
+// metadata calls fail quickly while generation and conversion get more time.
+// Zero fields fall back to Default, and Default falls back to Config.Timeout.
+type Timeouts struct {
+	// Connect limits establishing a connection, including the TLS handshake.
+	// It applies to the client's transport; a Clone that changes it gets its
+	// own HTTP client.
+	Connect time.Duration
+
+	// Generate applies to operations that produce or analyze documents:
+	// generation, conversion, stamping and document analysis.
+	Generate time.Duration
+
+	// Download applies to downloading stored or signed documents.
+	Download time.Duration
+
+	// Default applies to all other operations, such as template metadata
+	// and status polling.
+	Default time.Duration
+}
+
+// operationKind classifies a request for Timeouts.
+type operationKind int
+
+const (
+	operationDefault operationKind = iota
+	operationGenerate
+	operationDownload
+)
+
+type operationKey struct{}
+
+// withOperation marks requests made with ctx as kind.
+func withOperation(ctx context.Context, kind operationKind) context.Context {
+	return context.WithValue(ctx, operationKey{}, kind)
+}
+
+// attemptTimeout returns the per-attempt timeout for requests made with ctx.
+func (c *Client) attemptTimeout(ctx context.Context) time.Duration {
+	timeout := time.Duration(c.config.Timeout) * time.Second
+
+	timeouts := c.config.Timeouts
+	if timeouts == nil {
+		return timeout
+	}
+
+	if timeouts.Default > 0 {
+		timeout = timeouts.Default
+	}
+
+	kind, _ := ctx.Value(operationKey{}).(operationKind)
+	switch {
+	case kind == operationGenerate && timeouts.Generate > 0:
+		return timeouts.Generate
+	case kind == operationDownload && timeouts.Download > 0:
+		return timeouts.Download
+	}
+
+	return timeout
+}
+
+// newHTTPClient creates the HTTP client for a normalized config. Timeouts
+// are applied per attempt through the request context, not http.Client.Timeout.
+func newHTTPClient(config Config) *http.Client {
+	httpClient := &http.Client{Transport: config.Transport}
+
+	if config.Transport == nil && config.Timeouts != nil && config.Timeouts.Connect > 0 {
+		transport := http.DefaultTransport.(*http.Transport).Clone()
+		transport.DialContext = (&net.Dialer{
+			Timeout:   config.Timeouts.Connect,
+			KeepAlive: 30 * time.Second,
+		}).DialContext
+		transport.TLSHandshakeTimeout = config.Timeouts.Connect
+		httpClient.Transport = transport
+	}
+
+	return httpClient
+}
+
+// connectTimeout returns the connect timeout newHTTPClient applies for config.
+func connectTimeout(config Config) time.Duration {
+	if config.Timeouts == nil {
+		return 0
+	}
+	return config.Timeouts.Connect
+}
+
+// sameTransport reports whether a and b are the same transport. Transports
+// of types that are not comparable, other than funcs, are reported as different.
+func sameTransport(a, b http.RoundTripper) bool {
+	if a == nil || b == nil {
+		return a == nil && b == nil
+	}
+
+	typ := reflect.TypeOf(a)
+	switch {
+	case typ != reflect.TypeOf(b):
+		return false
+	case typ.Comparable():
+		return a == b
+	case typ.Kind() == reflect.Func:
+		return reflect.ValueOf(a).Pointer() == reflect.ValueOf(b).Pointer()
+	default:
+		return false
+	}
+}
+
+// seconds rounds d up to whole seconds, for TimeoutError.
+func seconds(d time.Duration) int {
+	return int(math.Ceil(d.Seconds()))
+}
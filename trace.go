@@ -0,0 +1,228 @@
+package documentstack
+
+import (
+	"crypto/tls"
+	"errors"
+	"io"
+	"log"
+	"net/http"
+	"net/http/httptrace"
+	"sync"
+	"time"
+)
+
+// Trace is the timing breakdown of a request attempt, for telling network
+// slowness apart from API slowness.
+type Trace struct {
+	// DNS is the time spent resolving the API host name.
+	DNS time.Duration
+
+	// Connect is the time spent establishing the TCP connection.
+	Connect time.Duration
+
+	// TLSHandshake is the time spent on the TLS handshake.
+	TLSHandshake time.Duration
+
+	// TimeToFirstByte is the time from the start of the attempt until the
+	// first response byte, including DNS, Connect and TLSHandshake.
+	TimeToFirstByte time.Duration
+
+	// Download is the time spent reading the response body.
+	Download time.Duration
+
+	// Total is the duration of the attempt, including reading the body.
+	Total time.Duration
+
+	// ReusedConnection reports whether the attempt used a pooled connection,
+	// in which case DNS, Connect and TLSHandshake are zero.
+	ReusedConnection bool
+
+	// RemoteAddr is the address of the API server that was connected to.
+	RemoteAddr string
+}
+
+// SlowResponse describes a request attempt that took longer than
+// Config.LatencyWarningThreshold.
+type SlowResponse struct {
+	Method string
+	Path   string
+
+	// StatusCode is the HTTP status, or 0 if the request failed before a response.
+	StatusCode int
+
+	Trace Trace
+}
+
+type tracerKey struct{}
+
+// requestTracer records a Trace through httptrace hooks.
+type requestTracer struct {
+	mu    sync.Mutex
+	start time.Time
+	trace Trace
+
+	dnsStart     time.Time
+	connectStart time.Time
+	tlsStart     time.Time
+	finished     bool
+}
+
+func newRequestTracer() *requestTracer {
+	return &requestTracer{start: time.Now()}
+}
+
+// clientTrace returns the httptrace hooks that record into t.
+func (t *requestTracer) clientTrace() *httptrace.ClientTrace {
+	return &httptrace.ClientTrace{
+		GotConn: func(info httptrace.GotConnInfo) {
+			t.mu.Lock()
+			t.trace.ReusedConnection = info.Reused
+			if info.Conn != nil {
+				t.trace.RemoteAddr = info.Conn.RemoteAddr().String()
+			}
+			t.mu.Unlock()
+		},
+		DNSStart: func(httptrace.DNSStartInfo) {
+			t.mu.Lock()
+			t.dnsStart = time.Now()
+			t.mu.Unlock()
+		},
+		DNSDone: func(httptrace.DNSDoneInfo) {
+			t.mu.Lock()
+			t.trace.DNS = time.Since(t.dnsStart)
+			t.mu.Unlock()
+		},
+		ConnectStart: func(string, string) {
+			t.mu.Lock()
+			t.connectStart = time.Now()
+			t.mu.Unlock()
+		},
+		ConnectDone: func(string, string, error) {
+			t.mu.Lock()
+			t.trace.Connect = time.Since(t.connectStart)
+			t.mu.Unlock()
+		},
+		TLSHandshakeStart: func() {
+			t.mu.Lock()
+			t.tlsStart = time.Now()
+			t.mu.Unlock()
+		},
+		TLSHandshakeDone: func(tls.ConnectionState, error) {
+			t.mu.Lock()
+			t.trace.TLSHandshake = time.Since(t.tlsStart)
+			t.mu.Unlock()
+		},
+		GotFirstResponseByte: func() {
+			t.mu.Lock()
+			t.trace.TimeToFirstByte = time.Since(t.start)
+			t.mu.Unlock()
+		},
+	}
+}
+
+// tracerFrom returns the tracer of the attempt that produced resp, if any.
+func tracerFrom(resp *http.Response) *requestTracer {
+	if resp.Request == nil {
+		return nil
+	}
+	tracer, _ := resp.Request.Context().Value(tracerKey{}).(*requestTracer)
+	return tracer
+}
+
+// responseTrace returns the trace of resp when Config.Trace is set. It is
+// completed by the tracer once the body has been read or closed.
+func (c *Client) responseTrace(resp *http.Response) *Trace {
+	if !c.config.Trace {
+		return nil
+	}
+	tracer := tracerFrom(resp)
+	if tracer == nil {
+		return nil
+	}
+	return &tracer.trace
+}
+
+// finish completes the trace. Calls after the first return the same trace.
+func (t *requestTracer) finish() Trace {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	if !t.finished {
+		t.finished = true
+		t.trace.Total = time.Since(t.start)
+		if t.trace.TimeToFirstByte > 0 {
+			t.trace.Download = t.trace.Total - t.trace.TimeToFirstByte
+		}
+	}
+	return t.trace
+}
+
+// traceDone finishes tracer, if any, records the trace on err (if non-nil)
+// when Config.Trace is set, and reports the attempt if it exceeded
+// Config.LatencyWarningThreshold.
+func (c *Client) traceDone(req *http.Request, statusCode int, tracer *requestTracer, err error) {
+	if tracer == nil {
+		return
+	}
+
+	trace := tracer.finish()
+	if c.config.Trace && err != nil {
+		attachTrace(err, &trace)
+	}
+
+	threshold := c.config.LatencyWarningThreshold
+	if threshold <= 0 || trace.Total < threshold {
+		return
+	}
+
+	slow := SlowResponse{
+		Method:     req.Method,
+		Path:       req.URL.Path,
+		StatusCode: statusCode,
+		Trace:      trace,
+	}
+
+	if c.config.OnSlowResponse != nil {
+		c.config.OnSlowResponse(slow)
+		return
+	}
+
+	log.Printf("[DocumentStack] Slow response: %s %s took %s (dns=%s connect=%s tls=%s ttfb=%s download=%s)\n",
+		slow.Method, slow.Path, trace.Total, trace.DNS, trace.Connect, trace.TLSHandshake, trace.TimeToFirstByte, trace.Download)
+}
+
+// attachTrace sets the Trace field of err.
+func attachTrace(err error, trace *Trace) {
+	var apiErr *APIError
+	switch e := err.(type) {
+	case *TimeoutError:
+		e.Trace = trace
+	case *NetworkError:
+		e.Trace = trace
+	default:
+		if errors.As(err, &apiErr) {
+			apiErr.Trace = trace
+		}
+	}
+}
+
+// tracedBody calls done once the body has been read to the end or closed.
+type tracedBody struct {
+	io.ReadCloser
+	once sync.Once
+	done func()
+}
+
+func (b *tracedBody) Read(p []byte) (int, error) {
+	n, err := b.ReadCloser.Read(p)
+	if err == io.EOF {
+		b.once.Do(b.done)
+	}
+	return n, err
+}
+
+func (b *tracedBody) Close() error {
+	err := b.ReadCloser.Close()
+	b.once.Do(b.done)
+	return err
+}
@@ -0,0 +1,267 @@
+package documentstack
+
+import (
+	"strings"
+	"unicode/utf8"
+)
+
+// DataTransform rewrites template data before it is sent, e.g. to mask or
+// drop values that must not leave the application. It receives a copy of the
+// data whose nested objects it may modify; slices are shared with the
+// caller's data and must be replaced rather than modified in place. It
+// returns the data to send.
+type DataTransform func(data map[string]interface{}) map[string]interface{}
+
+// sensitiveAuthKeys are data keys holding card sensitive authentication data,
+// which PCI DSS forbids storing after authorization. Compared case-insensitively.
+var sensitiveAuthKeys = map[string]bool{
+	"cvv":          true,
+	"cvv2":         true,
+	"cvc":          true,
+	"cvc2":         true,
+	"cid":          true,
+	"securitycode": true,
+	"pin":          true,
+	"pinblock":     true,
+	"track1":       true,
+	"track2":       true,
+	"trackdata":    true,
+}
+
+// PCIMasker returns the default PCI-friendly transform: it masks card
+// numbers in all string values (see MaskCardNumbers) and drops keys holding
+// sensitive authentication data, such as "cvv", "securityCode" or "pin", at
+// any depth.
+//
+// Example:
+//
+//	client, err := documentstack.New(documentstack.Config{
+//		APIKey:     apiKey,
+//		Transforms: []documentstack.DataTransform{documentstack.PCIMasker()},
+//	})
+func PCIMasker() DataTransform {
+	mask := MaskCardNumbers()
+	return func(data map[string]interface{}) map[string]interface{} {
+		return mask(dropSensitiveAuth(data))
+	}
+}
+
+// MaskCardNumbers returns a transform that replaces all but the last four
+// digits of card numbers in string values with '*', at any depth. A card
+// number is a run of 13 to 19 digits, optionally separated by single spaces
+// or dashes, that passes the Luhn check; separators are kept.
+func MaskCardNumbers() DataTransform {
+	return func(data map[string]interface{}) map[string]interface{} {
+		masked, _ := mapStrings(data, maskCardNumbers).(map[string]interface{})
+		return masked
+	}
+}
+
+// DropKeys returns a transform that removes the values at the given
+// dot-separated paths, e.g. "internal.costCenter". Missing paths are ignored.
+func DropKeys(paths ...string) DataTransform {
+	return func(data map[string]interface{}) map[string]interface{} {
+		for _, path := range paths {
+			removePath(data, strings.Split(path, "."))
+		}
+		return data
+	}
+}
+
+// TruncateFields returns a transform that shortens string values at the given
+// dot-separated paths to at most maxLen characters. Other values are left
+// unchanged.
+func TruncateFields(maxLen int, paths ...string) DataTransform {
+	return func(data map[string]interface{}) map[string]interface{} {
+		for _, path := range paths {
+			keys := strings.Split(path, ".")
+			value, ok := removePath(data, keys)
+			if !ok {
+				continue
+			}
+			if s, isString := value.(string); isString && utf8.RuneCountInString(s) > maxLen {
+				value = string([]rune(s)[:maxLen])
+			}
+			setPath(data, keys, value)
+		}
+		return data
+	}
+}
+
+// dropSensitiveAuth deletes sensitiveAuthKeys from data and from nested
+// objects, including objects in slices. Slices are rebuilt, not modified.
+func dropSensitiveAuth(data map[string]interface{}) map[string]interface{} {
+	for key, value := range data {
+		if sensitiveAuthKeys[strings.ToLower(key)] {
+			delete(data, key)
+			continue
+		}
+		data[key] = dropSensitiveAuthValue(value)
+	}
+	return data
+}
+
+func dropSensitiveAuthValue(value interface{}) interface{} {
+	switch v := value.(type) {
+	case map[string]interface{}:
+		return dropSensitiveAuth(v)
+	case []interface{}:
+		items := make([]interface{}, len(v))
+		for i, item := range v {
+			if nested, ok := item.(map[string]interface{}); ok {
+				item = dropSensitiveAuth(copyData(nested))
+			} else {
+				item = dropSensitiveAuthValue(item)
+			}
+			items[i] = item
+		}
+		return items
+	}
+	return value
+}
+
+// mapStrings returns value with fn applied to every string in it, rebuilding
+// objects and slices instead of modifying them.
+func mapStrings(value interface{}, fn func(string) string) interface{} {
+	switch v := value.(type) {
+	case string:
+		return fn(v)
+	case map[string]interface{}:
+		mapped := make(map[string]interface{}, len(v))
+		for key, item := range v {
+			mapped[key] = mapStrings(item, fn)
+		}
+		return mapped
+	case []interface{}:
+		mapped := make([]interface{}, len(v))
+		for i, item := range v {
+			mapped[i] = mapStrings(item, fn)
+		}
+		return mapped
+	case []string:
+		mapped := make([]string, len(v))
+		for i, item := range v {
+			mapped[i] = fn(item)
+		}
+		return mapped
+	}
+	return value
+}
+
+// maskCardNumbers masks the card numbers in s.
+func maskCardNumbers(s string) string {
+	var b []byte
+	for i := 0; i < len(s); {
+		if !isDigit(s[i]) || (i > 0 && isDigit(s[i-1])) {
+			i++
+			continue
+		}
+
+		groups := scanDigitGroups(s, i)
+		i = groups[len(groups)-1].end
+
+		// The run may hold a card number followed or preceded by other
+		// digits, e.g. an expiry date, so every window of whole groups is
+		// tried, longest first.
+		for first := 0; first < len(groups); {
+			last := cardWindow(s, groups[first:])
+			if last < 0 {
+				first++
+				continue
+			}
+			last += first
+
+			if b == nil {
+				b = []byte(s)
+			}
+			maskDigits(b[groups[first].start:groups[last].end])
+			first = last + 1
+		}
+	}
+
+	if b == nil {
+		return s
+	}
+	return string(b)
+}
+
+// digitGroup is a run of digits in s[start:end].
+type digitGroup struct {
+	start, end int
+}
+
+// scanDigitGroups returns the digit groups of the run starting at start,
+// separated by single spaces or dashes.
+func scanDigitGroups(s string, start int) []digitGroup {
+	groups := []digitGroup{{start: start, end: start}}
+	for i := start; i < len(s); i++ {
+		switch {
+		case isDigit(s[i]):
+			groups[len(groups)-1].end = i + 1
+		case (s[i] == ' ' || s[i] == '-') && i+1 < len(s) && isDigit(s[i+1]) && isDigit(s[i-1]):
+			groups = append(groups, digitGroup{start: i + 1, end: i + 1})
+		default:
+			return groups
+		}
+	}
+	return groups
+}
+
+// cardWindow returns the index of the last group of the longest card number
+// made of whole groups starting with groups[0], or -1 if there is none.
+func cardWindow(s string, groups []digitGroup) int {
+	for last := len(groups) - 1; last >= 0; last-- {
+		digits := 0
+		for _, group := range groups[:last+1] {
+			digits += group.end - group.start
+		}
+		window := s[groups[0].start:groups[last].end]
+		if digits >= 13 && digits <= 19 && luhnValid(window) {
+			return last
+		}
+	}
+	return -1
+}
+
+// maskDigits replaces all but the last four digits in b with asterisks.
+func maskDigits(b []byte) {
+	remaining := 0
+	for _, c := range b {
+		if isDigit(c) {
+			remaining++
+		}
+	}
+	for j, c := range b {
+		if isDigit(c) {
+			if remaining > 4 {
+				b[j] = '*'
+			}
+			remaining--
+		}
+	}
+}
+
+// luhnValid reports whether the digits in s pass the Luhn check.
+func luhnValid(s string) bool {
+	sum := 0
+	double := false
+	for i := len(s) - 1; i >= 0; i-- {
+		if !isDigit(s[i]) {
+			continue
+		}
+		d := int(s[i] - '0')
+		if double {
+			d *= 2
+			if d > 9 {
+				d -= 9
+			}
+		}
+		sum += d
+		double = !double
+	}
+	return sum%10 == 0
+}
+
+func isDigit(c byte) bool {
+	return c >= '0' && c <= '9'
+}
@@ -0,0 +1,29 @@
+package documentstack
+
+import "testing"
+
+func TestMaskCardNumbers(t *testing.T) {
+	tests := []struct {
+		in, want string
+	}{
+		{"4111111111111111", "************1111"},
+		{"card 4111-1111-1111-1111 on file", "card ****-****-****-1111 on file"},
+		{"card 4111111111111111 12 25", "card ************1111 12 25"},
+		{"Card: 4111 1111 1111 1111 1225", "Card: **** **** **** 1111 1225"},
+		{"4111111111111111 4111111111111111", "************1111 ************1111"},
+		{"exp 12 25 4111 1111 1111 1111", "exp 12 25 **** **** **** 1111"},
+		{"378282246310005", "***********0005"},
+		{"order 1234567890123456", "order 1234567890123456"},
+		{"phone 555 123 4567", "phone 555 123 4567"},
+		{"41111111111111111225", "41111111111111111225"},
+		{"", ""},
+	}
+
+	mask := MaskCardNumbers()
+	for _, test := range tests {
+		got := mask(map[string]interface{}{"v": test.in})["v"]
+		if got != test.want {
+			t.Errorf("MaskCardNumbers(%q) = %q, want %q", test.in, got, test.want)
+		}
+	}
+}
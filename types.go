@@ -1,6 +1,15 @@
 // Package documentstack provides a Go SDK for the DocumentStack PDF generation API.
 package documentstack
 
+import (
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"time"
+)
+
 // Config holds configuration options for the DocumentStack client.
 type Config struct {
 	// APIKey is the API key for authentication (Bearer token). Required.
@@ -10,21 +19,253 @@ type Config struct {
 	// Default: "https://api.documentstack.dev"
 	BaseURL string
 
-	// Timeout is the request timeout in seconds.
+	// Timeout is the timeout in seconds for a single request attempt.
 	// Default: 30
 	Timeout int
 
+	// Timeouts overrides Timeout by kind of operation.
+	Timeouts *Timeouts
+
+	// OperationTimeout is the overall timeout in seconds for an operation,
+	// including all retries and backoff. Zero means no limit beyond ctx.
+	// Default: 0
+	OperationTimeout int
+
+	// MaxRetries is the maximum number of retries after transient failures
+	// (network errors, attempt timeouts, 429, 502, 503 and 504). Retries
+	// respect Retry-After and are not started when the remaining ctx deadline
+	// is too short.
+	// Default: 0
+	MaxRetries int
+
+	// Transport is the HTTP transport used for requests, e.g. a Recorder.
+	// When set, Timeouts.Connect is not applied.
+	// Default: http.DefaultTransport
+	Transport http.RoundTripper
+
+	// LatencyWarningThreshold, if positive, reports request attempts that
+	// take longer, including reading the response body, to OnSlowResponse
+	// with a timing breakdown (DNS, connect, TLS, time to first byte,
+	// download). Without OnSlowResponse they are logged.
+	LatencyWarningThreshold time.Duration
+
+	// OnSlowResponse receives attempts slower than LatencyWarningThreshold.
+	OnSlowResponse func(slow SlowResponse)
+
+	// Trace records connection timing (DNS, TLS handshake, time to first
+	// byte, connection reuse) of the final attempt on generation responses
+	// and on APIError, TimeoutError and NetworkError.
+	// Default: false
+	Trace bool
+
+	// SigningSecret, if set, signs every request with HMAC-SHA256 in the
+	// X-DocumentStack-Timestamp and X-DocumentStack-Signature headers, for
+	// workspaces that require signed requests. Timestamps are corrected for
+	// the server clock offset measured from Date headers.
+	SigningSecret string
+
+	// MaxClockSkew is the largest tolerated difference between the local and
+	// the API server clock when signing requests. Signed requests the API
+	// rejects while the skew is larger fail with a *ClockSkewError instead of
+	// an *APIError.
+	// Default: 5m
+	MaxClockSkew time.Duration
+
+	// OnWarning, if set, receives the warnings of successful generations, as
+	// also returned in GenerateResponse.Warnings. Without OnWarning,
+	// deprecation warnings are logged once per template and variable.
+	OnWarning func(warning Warning)
+
 	// Headers are custom headers to include in all requests.
 	Headers map[string]string
 
-	// Debug enables debug logging.
+	// Debug enables debug logging. Secret fields of logged request bodies,
+	// such as EncryptionKey.Material, are redacted.
 	Debug bool
+
+	// Deduplicate collapses concurrent identical Generate calls (same template
+	// and request payload) into a single API call whose result is shared,
+	// e.g. for double-clicked download buttons.
+	// Default: false
+	Deduplicate bool
+
+	// SoftFail, if set, makes Generate return a placeholder PDF when
+	// generation fails with certain errors, so download flows degrade
+	// gracefully during incidents.
+	SoftFail *SoftFailConfig
+
+	// FallbackRenderer, if set, renders documents locally when Generate
+	// fails with a transient error after all retries. It is tried before
+	// SoftFail.
+	FallbackRenderer FallbackRenderer
+
+	// Migrations maps template IDs to data path migrations that Generate
+	// applies to request data before sending it, while call sites are
+	// updated after a template schema change.
+	Migrations map[string]MigrationMap
+
+	// Transforms rewrite request data, in order, before it is sent, e.g.
+	// PCIMasker, DropKeys or TruncateFields, so payloads can be sanitized in
+	// one place. They run after Migrations and before FieldEncryption.
+	Transforms []DataTransform
+
+	// FieldEncryption, if set, encrypts sensitive data fields of generation
+	// requests on the client before they are sent. See FieldEncryption for
+	// the calls it covers.
+	FieldEncryption *FieldEncryption
+
+	// downloadOnly restricts the client to GET and HEAD requests. Set by NewDownloadOnly.
+	downloadOnly bool
 }
 
 // GenerateOptions contains options for PDF generation.
 type GenerateOptions struct {
 	// Filename is the custom filename for the generated PDF (without .pdf extension).
 	Filename string `json:"filename,omitempty"`
+
+	// BrandingProfile is the ID of the branding profile to render with.
+	BrandingProfile string `json:"brandingProfile,omitempty"`
+
+	// Stationery overlays uploaded letterhead PDFs under every page.
+	Stationery *Stationery `json:"stationery,omitempty"`
+
+	// Prepend are IDs of stored documents whose pages are inserted before the
+	// generated pages, in order.
+	Prepend []string `json:"prepend,omitempty"`
+
+	// Append are IDs of stored documents whose pages are added after the
+	// generated pages, in order, e.g. standard terms and conditions.
+	Append []string `json:"append,omitempty"`
+
+	// Experiment renders one of several template variants, picked by the API
+	// by weight, to trial design changes on live traffic. The template ID
+	// passed to Generate is used for analytics grouping only.
+	Experiment *Experiment `json:"experiment,omitempty"`
+
+	// Priority is the queue priority of the generation within the account,
+	// e.g. PriorityHigh for interactive downloads and PriorityLow for
+	// nightly batches.
+	// Default: PriorityNormal
+	Priority Priority `json:"priority,omitempty"`
+
+	// Tags are key/value labels recorded with the generation for cost
+	// attribution, e.g. {"product": "billing", "customer": "cus_123"}. See
+	// UsageService.Report.
+	Tags map[string]string `json:"tags,omitempty"`
+
+	// Store keeps the generated document in DocumentStack, tagged with Tags,
+	// so it can be found later with DocumentsService.Search. Its ID is
+	// returned in GenerateResponse.DocumentID.
+	Store bool `json:"store,omitempty"`
+
+	// SkipDuplicates, with Store, does not store a copy of a document whose
+	// content checksum matches a stored document. DocumentID then refers to
+	// the existing document and Duplicate is set.
+	SkipDuplicates bool `json:"skipDuplicates,omitempty"`
+
+	// Strict fails the generation with a *MissingVariableError, instead of
+	// rendering blanks, when variables referenced by the template are absent
+	// from the data.
+	Strict bool `json:"strict,omitempty"`
+
+	// EmptyValues controls how nil, empty string and zero values render
+	// throughout the template.
+	// Default: rendered blank
+	EmptyValues *EmptyValuePolicy `json:"emptyValues,omitempty"`
+
+	// FieldEmptyValues overrides EmptyValues for data paths, e.g.
+	// "items.discount" for a field of every item.
+	FieldEmptyValues map[string]*EmptyValuePolicy `json:"fieldEmptyValues,omitempty"`
+
+	// CallbackURL, if set, receives a generation.completed or
+	// generation.failed event for this generation only, without registering
+	// a webhook, e.g. to notify the service that submitted a job. Deliveries
+	// are retried like webhook deliveries. Client retries of the generation
+	// share an idempotency key and do not cause duplicate callbacks.
+	CallbackURL string `json:"callbackUrl,omitempty"`
+
+	// CallbackSecret signs the deliveries to CallbackURL, so that the receiver
+	// can verify them with WebhookHandler or VerifyWebhookSignature.
+	CallbackSecret string `json:"callbackSecret,omitempty"`
+}
+
+// validate checks the options that can be checked without calling the API.
+func (o *GenerateOptions) validate() error {
+	if o == nil {
+		return nil
+	}
+
+	if o.Experiment != nil && len(o.Experiment.Variants) == 0 {
+		return NewValidationError("Experiment requires at least one variant", nil)
+	}
+
+	switch o.Priority {
+	case "", PriorityLow, PriorityNormal, PriorityHigh:
+	default:
+		return NewValidationError(fmt.Sprintf("Invalid priority %q", o.Priority), nil)
+	}
+
+	if o.CallbackURL != "" {
+		callback, err := url.Parse(o.CallbackURL)
+		if err != nil || (callback.Scheme != "http" && callback.Scheme != "https") || callback.Host == "" {
+			return NewValidationError(fmt.Sprintf("Invalid callback URL %q", o.CallbackURL), nil)
+		}
+	} else if o.CallbackSecret != "" {
+		return NewValidationError("CallbackSecret requires CallbackURL", nil)
+	}
+
+	if err := o.EmptyValues.validate(""); err != nil {
+		return err
+	}
+	for path, policy := range o.FieldEmptyValues {
+		if err := policy.validate(fmt.Sprintf(" at %q", path)); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// Priority is the queue priority of a generation.
+type Priority string
+
+// Generation priorities.
+const (
+	PriorityLow    Priority = "low"
+	PriorityNormal Priority = "normal"
+	PriorityHigh   Priority = "high"
+)
+
+// Experiment is a template A/B test.
+type Experiment struct {
+	// Name identifies the experiment in analytics.
+	Name string `json:"name"`
+
+	// Variants are the candidate templates.
+	Variants []ExperimentVariant `json:"variants"`
+}
+
+// ExperimentVariant is a candidate template in an Experiment.
+type ExperimentVariant struct {
+	// Name identifies the variant, e.g. "A" or "B".
+	Name string `json:"name"`
+
+	// TemplateID is the template rendered for this variant.
+	TemplateID string `json:"templateId"`
+
+	// Weight is the relative share of traffic rendered with this variant.
+	Weight int `json:"weight"`
+}
+
+// Stationery references uploaded single-page PDF assets rendered underneath
+// the generated pages, replicating pre-printed letterhead.
+type Stationery struct {
+	// AssetID is the background for every page, or for pages after the first
+	// when FirstPageAssetID is set.
+	AssetID string `json:"assetId,omitempty"`
+
+	// FirstPageAssetID is the background for the first page.
+	FirstPageAssetID string `json:"firstPageAssetId,omitempty"`
 }
 
 // GenerateRequest is the request payload for PDF generation.
@@ -34,6 +275,25 @@ type GenerateRequest struct {
 
 	// Options contains generation options.
 	Options *GenerateOptions `json:"options,omitempty"`
+
+	// Flags toggle conditional sections of the template, such as a terms page
+	// or a draft banner, separately from Data. TemplatesService.Schema lists
+	// the flags a template understands.
+	Flags map[string]bool `json:"flags,omitempty"`
+
+	// OverridesOnly declares that Data only contains the values that differ
+	// from the template's defaults, which the API deep-merges Data onto.
+	OverridesOnly bool `json:"overridesOnly,omitempty"`
+
+	// Migrations are data path migrations applied by the API before
+	// rendering. See MigrationMap.
+	Migrations MigrationMap `json:"migrations,omitempty"`
+
+	// Layers are data sources merged by the API in order before rendering,
+	// later layers taking precedence and Data applied last, as MergeData
+	// does client-side. Replaced values are reported as WarningDataConflict
+	// warnings.
+	Layers []DataLayer `json:"layers,omitempty"`
 }
 
 // GenerateResponse contains the generated PDF and metadata.
@@ -47,8 +307,111 @@ type GenerateResponse struct {
 	// GenerationTimeMs is the generation time in milliseconds.
 	GenerationTimeMs int64
 
+	// QueueWaitMs is how long the request waited in the API's render queue,
+	// in milliseconds, before generation started.
+	QueueWaitMs int64
+
+	// Region is the API region that rendered the document, e.g. "ap-northeast-1".
+	Region string
+
 	// ContentLength is the content length in bytes.
 	ContentLength int64
+
+	// Variant is the name of the experiment variant that was rendered, if
+	// GenerateOptions.Experiment was set.
+	Variant string
+
+	// DocumentID is the ID of the stored document, if GenerateOptions.Store was set.
+	DocumentID string
+
+	// Duplicate reports whether DocumentID refers to an existing document with
+	// identical content, with GenerateOptions.SkipDuplicates.
+	Duplicate bool
+
+	// Warnings are problems that did not prevent generation, such as missing
+	// optional variables, downscaled images or deprecated variables, so that
+	// partially rendered documents can be detected.
+	Warnings []Warning
+
+	// Trace is the connection timing of the request, if Config.Trace is set.
+	Trace *Trace
+
+	// Fallback reports whether PDF was produced by Config.FallbackRenderer or
+	// is the Config.SoftFail placeholder, because generation failed.
+	Fallback bool
+}
+
+// ListOptions controls pagination for list endpoints.
+type ListOptions struct {
+	// Limit is the maximum number of items to return per page.
+	Limit int
+
+	// Cursor is the cursor returned by a previous page, for fetching the next page.
+	Cursor string
+
+	// Filter filters and sorts the results. See Filter.
+	Filter *ListFilter
+}
+
+// values encodes the options as query parameters.
+func (o *ListOptions) values() url.Values {
+	values := url.Values{}
+	if o == nil {
+		return values
+	}
+	if o.Limit > 0 {
+		values.Set("limit", strconv.Itoa(o.Limit))
+	}
+	if o.Cursor != "" {
+		values.Set("cursor", o.Cursor)
+	}
+	o.Filter.encode(values)
+	return values
+}
+
+// StreamResponse contains the unread PDF body and metadata.
+type StreamResponse struct {
+	// Body is the PDF binary stream. The caller must close it.
+	Body io.ReadCloser
+
+	// ContentType is the MIME type of the body.
+	ContentType string
+
+	// Filename is the filename from Content-Disposition header.
+	Filename string
+
+	// GenerationTimeMs is the generation time in milliseconds.
+	GenerationTimeMs int64
+
+	// QueueWaitMs is how long the request waited in the API's render queue,
+	// in milliseconds, before generation started.
+	QueueWaitMs int64
+
+	// Region is the API region that rendered the document.
+	Region string
+
+	// ContentLength is the content length in bytes, or -1 if unknown.
+	ContentLength int64
+
+	// Variant is the name of the experiment variant that was rendered, if
+	// GenerateOptions.Experiment was set.
+	Variant string
+
+	// DocumentID is the ID of the stored document, if GenerateOptions.Store was set.
+	DocumentID string
+
+	// Duplicate reports whether DocumentID refers to an existing document with
+	// identical content, with GenerateOptions.SkipDuplicates.
+	Duplicate bool
+
+	// Warnings are problems that did not prevent generation, such as missing
+	// optional variables, downscaled images or deprecated variables, so that
+	// partially rendered documents can be detected.
+	Warnings []Warning
+
+	// Trace is the connection timing of the request, if Config.Trace is set.
+	// Its Download and Total are set once Body has been read or closed.
+	Trace *Trace
 }
 
 // APIErrorResponse represents an error response from the API.
@@ -56,4 +419,5 @@ type APIErrorResponse struct {
 	Error   string      `json:"error"`
 	Message string      `json:"message"`
 	Details interface{} `json:"details,omitempty"`
+	DocsURL string      `json:"docsUrl,omitempty"`
 }
@@ -0,0 +1,305 @@
+package documentstack
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"mime/multipart"
+	"net/textproto"
+	"net/url"
+	"sync"
+	"time"
+)
+
+const defaultUploadChunkSize = 8 << 20
+
+// DocumentMetadata describes a document uploaded to storage.
+type DocumentMetadata struct {
+	// Filename is the document filename, e.g. "invoice-4711.pdf". Required.
+	Filename string `json:"filename"`
+
+	// Tags are key/value labels for search. See DocumentsService.Search.
+	Tags map[string]string `json:"tags,omitempty"`
+
+	// OnBehalfOf is the customer ID the document belongs to. See WithOnBehalfOf.
+	OnBehalfOf string `json:"onBehalfOf,omitempty"`
+
+	// CreatedAt overrides the creation time of the stored document, e.g. the
+	// original date of an imported document. Retention policies count from it.
+	CreatedAt *time.Time `json:"createdAt,omitempty"`
+
+	// SkipDuplicates does not store a copy of a document whose content
+	// checksum matches a stored document; the existing document is returned
+	// with Duplicate set instead.
+	SkipDuplicates bool `json:"skipDuplicates,omitempty"`
+}
+
+func (m *DocumentMetadata) validate() error {
+	if m == nil || m.Filename == "" {
+		return NewValidationError("Document filename is required", nil)
+	}
+	return nil
+}
+
+// Upload stores the PDF read from r, e.g. to import documents from another
+// system so they can be searched, shared and retained like generated ones.
+// The whole PDF is buffered in memory; use UploadResumable for large files.
+//
+// Example:
+//
+//	f, err := os.Open("legacy/invoice-4711.pdf")
+//	if err != nil {
+//		return err
+//	}
+//	defer f.Close()
+//	document, err := client.Documents.Upload(ctx, f, &documentstack.DocumentMetadata{
+//		Filename: "invoice-4711.pdf",
+//		Tags:     map[string]string{"source": "legacy"},
+//	})
+func (s *DocumentsService) Upload(ctx context.Context, r io.Reader, metadata *DocumentMetadata) (*Document, error) {
+	if err := metadata.validate(); err != nil {
+		return nil, err
+	}
+
+	var body bytes.Buffer
+	writer := multipart.NewWriter(&body)
+
+	header := make(textproto.MIMEHeader)
+	header.Set("Content-Disposition", fmt.Sprintf(`form-data; name="file"; filename="%s"`, escapeQuotes(metadata.Filename)))
+	header.Set("Content-Type", "application/pdf")
+
+	part, err := writer.CreatePart(header)
+	if err != nil {
+		return nil, &NetworkError{Message: "failed to create multipart body", Cause: err}
+	}
+	hash := sha256.New()
+	if _, err := io.Copy(part, io.TeeReader(r, hash)); err != nil {
+		return nil, &NetworkError{Message: "failed to read document", Cause: err}
+	}
+
+	// The checksum lets the API verify the upload and detect duplicates.
+	encoded, err := json.Marshal(struct {
+		*DocumentMetadata
+		Checksum string `json:"checksum"`
+	}{metadata, hex.EncodeToString(hash.Sum(nil))})
+	if err != nil {
+		return nil, &NetworkError{Message: "failed to marshal document metadata", Cause: err}
+	}
+	if err := writer.WriteField("metadata", string(encoded)); err != nil {
+		return nil, &NetworkError{Message: "failed to create multipart body", Cause: err}
+	}
+	if err := writer.Close(); err != nil {
+		return nil, &NetworkError{Message: "failed to create multipart body", Cause: err}
+	}
+
+	req, err := s.client.newRequest(ctx, "POST", "/api/v1/documents", &body, writer.FormDataContentType())
+	if err != nil {
+		return nil, err
+	}
+
+	var document Document
+	if err := s.client.decode(ctx, req, &document); err != nil {
+		return nil, err
+	}
+
+	return &document, nil
+}
+
+// UploadItem is a document in an UploadBatch.
+type UploadItem struct {
+	// Open opens the PDF. It is called when the item is uploaded, so that
+	// large batches do not hold every file open.
+	Open func() (io.ReadCloser, error)
+
+	Metadata *DocumentMetadata
+}
+
+// UploadResult is the outcome of an UploadBatch item.
+type UploadResult struct {
+	// Index is the position of the item in the batch.
+	Index int
+
+	// Document is the stored document, if the upload succeeded.
+	Document *Document
+
+	// Err is the error, if the upload failed or ctx was done before it started.
+	Err error
+}
+
+// UploadBatch uploads items with concurrency parallel uploads and returns
+// their outcomes in order. A failing item does not stop the batch.
+// Default concurrency: 4
+func (s *DocumentsService) UploadBatch(ctx context.Context, items []UploadItem, concurrency int) []UploadResult {
+	if concurrency <= 0 {
+		concurrency = defaultBatchConcurrency
+	}
+
+	results := make([]UploadResult, len(items))
+	work := make(chan int)
+	var wg sync.WaitGroup
+
+	for i := 0; i < concurrency; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for index := range work {
+				results[index] = s.uploadItem(ctx, index, items[index])
+			}
+		}()
+	}
+
+	for index := range items {
+		work <- index
+	}
+	close(work)
+	wg.Wait()
+
+	return results
+}
+
+// uploadItem uploads a single UploadBatch item.
+func (s *DocumentsService) uploadItem(ctx context.Context, index int, item UploadItem) UploadResult {
+	result := UploadResult{Index: index}
+	if result.Err = ctx.Err(); result.Err != nil {
+		return result
+	}
+	if item.Open == nil {
+		result.Err = NewValidationError("Upload item Open is required", nil)
+		return result
+	}
+
+	r, err := item.Open()
+	if err != nil {
+		result.Err = &DocumentStackError{Message: fmt.Sprintf("failed to open document: %v", err)}
+		return result
+	}
+	defer r.Close()
+
+	result.Document, result.Err = s.Upload(ctx, r, item.Metadata)
+	return result
+}
+
+// UploadSession is a resumable upload. Its ID can be persisted to resume an
+// interrupted upload with ResumeUpload, until ExpiresAt.
+type UploadSession struct {
+	ID string `json:"id"`
+
+	// Size is the total size of the document in bytes.
+	Size int64 `json:"size"`
+
+	// Offset is the number of bytes received by the API so far.
+	Offset int64 `json:"offset"`
+
+	// DocumentID is the ID of the stored document, once the upload is complete.
+	DocumentID string `json:"documentId,omitempty"`
+
+	ExpiresAt time.Time `json:"expiresAt"`
+}
+
+// ResumableUploadOptions controls a resumable upload.
+type ResumableUploadOptions struct {
+	// ChunkSize is the size in bytes of each uploaded chunk. An interrupted
+	// upload loses at most one chunk.
+	// Default: 8 MiB
+	ChunkSize int64
+}
+
+// CreateUpload starts a resumable upload of a document of size bytes.
+// Upload its content with ResumeUpload.
+func (s *DocumentsService) CreateUpload(ctx context.Context, size int64, metadata *DocumentMetadata) (*UploadSession, error) {
+	if err := metadata.validate(); err != nil {
+		return nil, err
+	}
+	if size <= 0 {
+		return nil, NewValidationError("Document size must be positive", nil)
+	}
+
+	body := struct {
+		*DocumentMetadata
+		Size int64 `json:"size"`
+	}{metadata, size}
+
+	var result UploadSession
+	if err := s.client.doJSON(ctx, "POST", "/api/v1/documents/uploads", body, &result); err != nil {
+		return nil, err
+	}
+
+	return &result, nil
+}
+
+// ResumeUpload uploads the remainder of the document in r to the upload
+// session, starting at the offset the API has received, and returns the
+// stored document. Each chunk is retried according to Config.MaxRetries.
+//
+// Example:
+//
+//	session, err := client.Documents.CreateUpload(ctx, size, metadata)
+//	if err != nil {
+//		return err
+//	}
+//	saveSessionID(session.ID)
+//	document, err := client.Documents.ResumeUpload(ctx, session.ID, f, nil)
+//	// After an interruption, call ResumeUpload again with the saved session ID.
+func (s *DocumentsService) ResumeUpload(ctx context.Context, sessionID string, r io.ReaderAt, opts *ResumableUploadOptions) (*Document, error) {
+	if sessionID == "" {
+		return nil, NewValidationError("Upload session ID is required", nil)
+	}
+
+	chunkSize := int64(defaultUploadChunkSize)
+	if opts != nil && opts.ChunkSize > 0 {
+		chunkSize = opts.ChunkSize
+	}
+
+	endpoint := fmt.Sprintf("/api/v1/documents/uploads/%s", url.PathEscape(sessionID))
+
+	var session UploadSession
+	if err := s.client.doJSON(ctx, "GET", endpoint, nil, &session); err != nil {
+		return nil, err
+	}
+
+	chunk := make([]byte, chunkSize)
+	for session.DocumentID == "" {
+		if session.Offset >= session.Size {
+			return nil, &DocumentStackError{Message: fmt.Sprintf("upload session %s received all bytes but did not complete", sessionID)}
+		}
+
+		n, err := r.ReadAt(chunk[:min(chunkSize, session.Size-session.Offset)], session.Offset)
+		if n == 0 && err != nil {
+			return nil, &DocumentStackError{Message: fmt.Sprintf("failed to read document at offset %d: %v", session.Offset, err)}
+		}
+
+		req, err := s.client.newRequest(ctx, "PUT", endpoint, bytes.NewReader(chunk[:n]), "application/octet-stream")
+		if err != nil {
+			return nil, err
+		}
+		req.Header.Set("Content-Range", fmt.Sprintf("bytes %d-%d/%d", session.Offset, session.Offset+int64(n)-1, session.Size))
+
+		offset := session.Offset
+		if err := s.client.decode(ctx, req, &session); err != nil {
+			return nil, err
+		}
+		if session.DocumentID == "" && session.Offset <= offset {
+			return nil, &DocumentStackError{Message: fmt.Sprintf("upload session %s did not accept the chunk at offset %d", sessionID, offset)}
+		}
+	}
+
+	return s.Get(ctx, session.DocumentID)
+}
+
+// UploadResumable uploads a document of size bytes from r in chunks, like
+// CreateUpload followed by ResumeUpload. If it fails after the session was
+// created, the session is returned with the error, so the upload can be
+// resumed with ResumeUpload.
+func (s *DocumentsService) UploadResumable(ctx context.Context, r io.ReaderAt, size int64, metadata *DocumentMetadata, opts *ResumableUploadOptions) (*Document, *UploadSession, error) {
+	session, err := s.CreateUpload(ctx, size, metadata)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	document, err := s.ResumeUpload(ctx, session.ID, r, opts)
+	return document, session, err
+}
@@ -0,0 +1,106 @@
+package documentstack
+
+import (
+	"context"
+	"net/url"
+	"time"
+)
+
+// UsageService reports generation usage and cost.
+type UsageService struct {
+	client *Client
+}
+
+// UsageFilter selects the generations counted in a usage report.
+type UsageFilter struct {
+	// From and To limit the report to generations in [From, To). Zero values
+	// default to the current billing period.
+	From time.Time
+	To   time.Time
+
+	// Tags limits the report to generations with all of these tags. See
+	// GenerateOptions.Tags.
+	Tags map[string]string
+
+	// OnBehalfOf limits the report to generations attributed to this
+	// customer ID. See WithOnBehalfOf.
+	OnBehalfOf string
+
+	// GroupBy is a tag key to break the report down by, e.g. "product".
+	GroupBy string
+}
+
+func (f *UsageFilter) values() url.Values {
+	values := url.Values{}
+	if f == nil {
+		return values
+	}
+
+	if !f.From.IsZero() {
+		values.Set("from", f.From.UTC().Format(time.RFC3339))
+	}
+	if !f.To.IsZero() {
+		values.Set("to", f.To.UTC().Format(time.RFC3339))
+	}
+	for _, key := range sortedKeys(f.Tags) {
+		values.Add("tag", key+":"+f.Tags[key])
+	}
+	if f.OnBehalfOf != "" {
+		values.Set("onBehalfOf", f.OnBehalfOf)
+	}
+	if f.GroupBy != "" {
+		values.Set("groupBy", f.GroupBy)
+	}
+	return values
+}
+
+// UsageTotals are usage counts and cost. Cost is in the smallest currency unit,
+// e.g. cents.
+type UsageTotals struct {
+	Generations int64 `json:"generations"`
+	Pages       int64 `json:"pages"`
+	Cost        int64 `json:"cost"`
+}
+
+// UsageGroup is the usage of generations with one value of UsageFilter.GroupBy.
+type UsageGroup struct {
+	UsageTotals
+
+	// Value is the tag value. Empty groups generations without the tag.
+	Value string `json:"value"`
+}
+
+// UsageReport is the usage of the generations selected by a UsageFilter.
+type UsageReport struct {
+	UsageTotals
+
+	From     time.Time `json:"from"`
+	To       time.Time `json:"to"`
+	Currency string    `json:"currency"`
+
+	// Groups breaks the totals down by UsageFilter.GroupBy, if set.
+	Groups []UsageGroup `json:"groups,omitempty"`
+}
+
+// Report returns the usage of the generations selected by filter, which may be
+// nil for the whole workspace in the current billing period.
+//
+// Example:
+//
+//	report, err := client.Usage.Report(ctx, &documentstack.UsageFilter{
+//		Tags:    map[string]string{"customer": customerID},
+//		GroupBy: "product",
+//	})
+func (s *UsageService) Report(ctx context.Context, filter *UsageFilter) (*UsageReport, error) {
+	endpoint := "/api/v1/usage"
+	if query := filter.values().Encode(); query != "" {
+		endpoint += "?" + query
+	}
+
+	var result UsageReport
+	if err := s.client.doJSON(ctx, "GET", endpoint, nil, &result); err != nil {
+		return nil, err
+	}
+
+	return &result, nil
+}
@@ -0,0 +1,234 @@
+package documentstack
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+)
+
+// VerifyOptions lists what Client.Verify requires.
+type VerifyOptions struct {
+	// Scopes the API key must have, e.g. ScopeGenerate.
+	Scopes []string
+
+	// Region the API must be served from, e.g. "eu-central-1". Empty accepts any region.
+	Region string
+
+	// Templates are the templates the application depends on.
+	Templates *TemplateRegistry
+
+	// Features that must be enabled for the account.
+	Features []Feature
+
+	// MaxClockSkew is the largest accepted difference between the local and
+	// the API server clock.
+	// Default: Config.MaxClockSkew
+	MaxClockSkew time.Duration
+}
+
+// VerifyCheck is the outcome of one check in a VerifyReport.
+type VerifyCheck struct {
+	// Name identifies the check: "api_key", "scopes", "region", "clock_skew",
+	// "templates" or "features".
+	Name string
+
+	OK bool
+
+	// Message describes the failure, or the observed value on success.
+	Message string
+}
+
+// VerifyReport is the result of Client.Verify.
+type VerifyReport struct {
+	// APIKey is the key the client authenticates with. Its secret is not included.
+	APIKey *APIKey
+
+	// Region is the API region that served the requests.
+	Region string
+
+	// Latency is the round-trip time of the API key request.
+	Latency time.Duration
+
+	// ClockSkew is the server clock minus the local clock, to about a second.
+	ClockSkew time.Duration
+
+	Checks []VerifyCheck
+}
+
+// OK reports whether all checks passed.
+func (r *VerifyReport) OK() bool {
+	for _, check := range r.Checks {
+		if !check.OK {
+			return false
+		}
+	}
+	return true
+}
+
+// Failed returns the checks that did not pass.
+func (r *VerifyReport) Failed() []VerifyCheck {
+	var failed []VerifyCheck
+	for _, check := range r.Checks {
+		if !check.OK {
+			failed = append(failed, check)
+		}
+	}
+	return failed
+}
+
+// VerifyError is returned by Client.Verify when checks failed.
+type VerifyError struct {
+	Report *VerifyReport
+}
+
+func (e *VerifyError) Error() string {
+	failed := e.Report.Failed()
+	messages := make([]string, len(failed))
+	for i, check := range failed {
+		messages[i] = fmt.Sprintf("%s: %s", check.Name, check.Message)
+	}
+	return "self-check failed: " + strings.Join(messages, "; ")
+}
+
+// Verify checks in one call that the client can work as configured: the API
+// is reachable and accepts the key, the key has the required scopes, the
+// region and clock skew are acceptable, and required templates and features
+// are present. It is meant for service initialization and readiness probes.
+//
+// The report is returned whenever the API could be reached; if any check
+// failed the error is a *VerifyError.
+//
+// Example:
+//
+//	report, err := client.Verify(ctx, &documentstack.VerifyOptions{
+//		Scopes:    []string{documentstack.ScopeGenerate},
+//		Templates: templates,
+//	})
+//	if err != nil {
+//		log.Fatal(err)
+//	}
+func (c *Client) Verify(ctx context.Context, opts *VerifyOptions) (*VerifyReport, error) {
+	if opts == nil {
+		opts = &VerifyOptions{}
+	}
+	maxSkew := opts.MaxClockSkew
+	if maxSkew <= 0 {
+		maxSkew = c.maxClockSkew()
+	}
+
+	req, err := c.newRequest(ctx, "GET", "/api/v1/api-keys/current", nil, "")
+	if err != nil {
+		return nil, err
+	}
+
+	sent := time.Now()
+	resp, err := c.send(ctx, req)
+	if err != nil {
+		var apiErr *APIError
+		if errors.As(err, &apiErr) && apiErr.IsAuthenticationError() {
+			report := &VerifyReport{Checks: []VerifyCheck{{Name: "api_key", Message: apiErr.Message}}}
+			return report, &VerifyError{Report: report}
+		}
+		return nil, err
+	}
+	received := time.Now()
+
+	report := &VerifyReport{
+		APIKey:    &APIKey{},
+		Region:    resp.Header.Get("X-Region"),
+		Latency:   received.Sub(sent),
+		ClockSkew: clockSkew(resp, sent, received),
+	}
+	if err := decodeResponse(resp, report.APIKey); err != nil {
+		return nil, err
+	}
+
+	report.Checks = append(report.Checks, VerifyCheck{Name: "api_key", OK: true, Message: report.APIKey.Prefix})
+	report.Checks = append(report.Checks, checkScopes(report.APIKey.Scopes, opts.Scopes))
+
+	regionCheck := VerifyCheck{Name: "region", OK: opts.Region == "" || opts.Region == report.Region, Message: report.Region}
+	if !regionCheck.OK {
+		regionCheck.Message = fmt.Sprintf("served from %q, want %q", report.Region, opts.Region)
+	}
+	report.Checks = append(report.Checks, regionCheck)
+
+	skew := report.ClockSkew
+	if skew < 0 {
+		skew = -skew
+	}
+	skewCheck := VerifyCheck{Name: "clock_skew", OK: skew <= maxSkew, Message: report.ClockSkew.String()}
+	if !skewCheck.OK {
+		skewCheck.Message = fmt.Sprintf("local clock is off by %s (max %s)", report.ClockSkew, maxSkew)
+	}
+	report.Checks = append(report.Checks, skewCheck)
+
+	if opts.Templates != nil {
+		templatesCheck := VerifyCheck{Name: "templates", OK: true}
+		if err := opts.Templates.Verify(ctx, c); err != nil {
+			templatesCheck.OK = false
+			templatesCheck.Message = err.Error()
+		}
+		report.Checks = append(report.Checks, templatesCheck)
+	}
+
+	if len(opts.Features) > 0 {
+		capabilities, err := c.Capabilities(ctx)
+		if err != nil {
+			return nil, err
+		}
+		featuresCheck := VerifyCheck{Name: "features", OK: true}
+		var missing []string
+		for _, feature := range opts.Features {
+			if !capabilities.Has(feature) {
+				missing = append(missing, string(feature))
+			}
+		}
+		if len(missing) > 0 {
+			featuresCheck.OK = false
+			featuresCheck.Message = "not enabled: " + strings.Join(missing, ", ")
+		}
+		report.Checks = append(report.Checks, featuresCheck)
+	}
+
+	if !report.OK() {
+		return report, &VerifyError{Report: report}
+	}
+	return report, nil
+}
+
+// checkScopes checks that granted includes every required scope. The admin
+// scope grants all scopes.
+func checkScopes(granted, required []string) VerifyCheck {
+	has := make(map[string]bool, len(granted))
+	for _, scope := range granted {
+		has[scope] = true
+	}
+
+	var missing []string
+	for _, scope := range required {
+		if !has[scope] && !has[ScopeAdmin] {
+			missing = append(missing, scope)
+		}
+	}
+
+	if len(missing) > 0 {
+		return VerifyCheck{Name: "scopes", Message: "missing " + strings.Join(missing, ", ")}
+	}
+	return VerifyCheck{Name: "scopes", OK: true, Message: strings.Join(granted, ", ")}
+}
+
+// clockSkew estimates the server clock minus the local clock from the Date
+// header of resp, assuming the server stamped it halfway through the round
+// trip. It returns 0 if the header is missing.
+func clockSkew(resp *http.Response, sent, received time.Time) time.Duration {
+	serverTime, err := http.ParseTime(resp.Header.Get("Date"))
+	if err != nil {
+		return 0
+	}
+
+	local := sent.Add(received.Sub(sent) / 2)
+	return serverTime.Sub(local).Truncate(time.Second)
+}
@@ -0,0 +1,154 @@
+package documentstack
+
+import (
+	"encoding/json"
+	"fmt"
+	"log"
+	"net/http"
+	"strings"
+	"time"
+)
+
+// warningsHeader carries the warnings of a generation as a JSON array.
+const warningsHeader = "X-DocumentStack-Warnings"
+
+// WarningCode identifies the kind of a Warning.
+type WarningCode string
+
+// Warning codes.
+const (
+	// WarningDeprecatedTemplate reports that the template is deprecated.
+	WarningDeprecatedTemplate WarningCode = "deprecated_template"
+
+	// WarningDeprecatedVariable reports that the request set a deprecated variable.
+	WarningDeprecatedVariable WarningCode = "deprecated_variable"
+
+	// WarningMissingVariable reports that an optional variable was not set
+	// and its section rendered empty.
+	WarningMissingVariable WarningCode = "missing_variable"
+
+	// WarningImageDownscaled reports that an image was downscaled to fit the
+	// size or resolution limits.
+	WarningImageDownscaled WarningCode = "image_downscaled"
+
+	// WarningFontSubstituted reports that a font was unavailable and a
+	// fallback font was used.
+	WarningFontSubstituted WarningCode = "font_substituted"
+
+	// WarningContentTruncated reports that content overflowed its container
+	// and was cut off.
+	WarningContentTruncated WarningCode = "content_truncated"
+
+	// WarningDataConflict reports that a GenerateRequest.Layers layer
+	// replaced a value set by an earlier layer.
+	WarningDataConflict WarningCode = "data_conflict"
+
+	// WarningOther is a warning without a specific code, e.g. from a standard
+	// HTTP Warning header.
+	WarningOther WarningCode = "other"
+)
+
+// Deprecation describes a deprecated template or variable.
+type Deprecation struct {
+	// Message explains the deprecation and what to do instead.
+	Message string `json:"message"`
+
+	// SunsetAt is when the template or variable will be removed, if scheduled.
+	SunsetAt *time.Time `json:"sunsetAt,omitempty"`
+
+	// Replacement is the template ID or variable path to use instead, if any.
+	Replacement string `json:"replacement,omitempty"`
+}
+
+// Warning is a problem with a request that did not prevent it from
+// succeeding, such as the use of a deprecated variable.
+type Warning struct {
+	Code    WarningCode `json:"code"`
+	Message string      `json:"message"`
+
+	// TemplateID is the template the warning concerns, if any.
+	TemplateID string `json:"templateId,omitempty"`
+
+	// Path is the data variable path the warning concerns, if any.
+	Path string `json:"path,omitempty"`
+
+	// Deprecation describes the deprecation, for deprecation warnings.
+	Deprecation *Deprecation `json:"deprecation,omitempty"`
+}
+
+func (w Warning) String() string {
+	if w.Path != "" {
+		return fmt.Sprintf("%s: %s (%s)", w.Code, w.Message, w.Path)
+	}
+	return fmt.Sprintf("%s: %s", w.Code, w.Message)
+}
+
+// responseWarnings returns the warnings of resp, from the API warnings
+// header and standard HTTP Warning headers, and reports them to
+// Config.OnWarning.
+func (c *Client) responseWarnings(resp *http.Response) []Warning {
+	var warnings []Warning
+
+	if header := resp.Header.Get(warningsHeader); header != "" {
+		if err := json.Unmarshal([]byte(header), &warnings); err != nil {
+			warnings = nil
+			if c.config.Debug {
+				log.Printf("[DocumentStack] Ignoring malformed %s header: %v\n", warningsHeader, err)
+			}
+		}
+	}
+
+	for _, header := range resp.Header.Values("Warning") {
+		if text := httpWarningText(header); text != "" {
+			warnings = append(warnings, Warning{Code: WarningOther, Message: text})
+		}
+	}
+
+	for _, warning := range warnings {
+		c.reportWarning(warning)
+	}
+
+	return warnings
+}
+
+// httpWarningText returns the text of an HTTP Warning header value such as
+// `199 - "image downscaled"`, or the whole value if it is not quoted.
+func httpWarningText(value string) string {
+	start := strings.IndexByte(value, '"')
+	if start < 0 {
+		return strings.TrimSpace(value)
+	}
+	end := strings.IndexByte(value[start+1:], '"')
+	if end < 0 {
+		return strings.TrimSpace(value)
+	}
+	return value[start+1 : start+1+end]
+}
+
+// reportWarning passes warning to Config.OnWarning. Without OnWarning,
+// deprecation warnings are logged once per client, template and path, so
+// call sites learn about upcoming template changes.
+func (c *Client) reportWarning(warning Warning) {
+	if c.config.OnWarning != nil {
+		c.config.OnWarning(warning)
+		return
+	}
+
+	if warning.Deprecation == nil {
+		return
+	}
+	key := warning.TemplateID + "\x00" + warning.Path
+	if _, seen := c.deprecations.LoadOrStore(key, true); seen {
+		return
+	}
+
+	message := "[DocumentStack] Deprecated"
+	if warning.Path != "" {
+		message += " variable " + warning.Path + " in"
+	}
+	message += " template " + warning.TemplateID + ": " + warning.Deprecation.Message
+	if warning.Deprecation.SunsetAt != nil {
+		message += " (sunset " + warning.Deprecation.SunsetAt.Format("2006-01-02") + ")"
+	}
+	log.Println(message)
+}
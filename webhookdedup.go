@@ -0,0 +1,135 @@
+package documentstack
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"time"
+)
+
+const (
+	defaultEventDedupTTL = 72 * time.Hour
+
+	// eventProcessingTTL bounds the claim on an event while it is processed,
+	// so that a crash mid-processing does not suppress the redelivery.
+	eventProcessingTTL = 5 * time.Minute
+)
+
+// EventStore records the IDs of webhook events that have been processed, for
+// DeduplicateEvents. Implementations must be safe for concurrent use and,
+// when several processes receive webhooks, shared between them. A Redis
+// implementation is a SET with NX and EX for Claim, a SET with XX and EX for
+// Complete and a DEL for Release.
+type EventStore interface {
+	// Claim records id for ttl and reports whether it was not already recorded.
+	Claim(ctx context.Context, id string, ttl time.Duration) (bool, error)
+
+	// Complete keeps the record of a claimed id for ttl from now.
+	Complete(ctx context.Context, id string, ttl time.Duration) error
+
+	// Release removes id, so that a redelivery of the event is processed.
+	// Releasing a missing ID is not an error.
+	Release(ctx context.Context, id string) error
+}
+
+// DeduplicateEvents wraps a webhook event handler so that each event ID is
+// processed at most once within ttl. DocumentStack delivers events at least
+// once, so an event may arrive again after a timeout or a lost response.
+// Duplicates are acknowledged without calling handle.
+//
+// An event is claimed for 5 minutes while handle runs, and for ttl once it
+// succeeds. If handle fails the claim is released, so that the retried
+// delivery is processed; if the process crashes, the claim expires and a
+// redelivery after 5 minutes is processed. Handlers running longer than 5
+// minutes may therefore process a redelivery concurrently. Events without an
+// ID are always processed.
+// Default ttl: 72h
+//
+// Example:
+//
+//	store := documentstack.NewMemoryEventStore()
+//	mux.Handle("/hooks/documentstack", documentstack.WebhookHandler(secret,
+//		documentstack.DeduplicateEvents(store, 0, handleEvent), nil))
+func DeduplicateEvents(store EventStore, ttl time.Duration, handle func(ctx context.Context, event *Event) error) func(ctx context.Context, event *Event) error {
+	if ttl <= 0 {
+		ttl = defaultEventDedupTTL
+	}
+
+	return func(ctx context.Context, event *Event) error {
+		if event.ID == "" {
+			return handle(ctx, event)
+		}
+
+		claimed, err := store.Claim(ctx, event.ID, eventProcessingTTL)
+		if err != nil {
+			return err
+		}
+		if !claimed {
+			return nil
+		}
+
+		err = handle(ctx, event)
+
+		// Update the claim with a fresh context: ctx may be canceled already.
+		storeCtx, cancel := context.WithTimeout(context.WithoutCancel(ctx), 5*time.Second)
+		defer cancel()
+
+		if err != nil {
+			// A lingering claim would drop the retried delivery.
+			return errors.Join(err, store.Release(storeCtx, event.ID))
+		}
+
+		return store.Complete(storeCtx, event.ID, ttl)
+	}
+}
+
+// MemoryEventStore is an in-memory EventStore. Claims are lost on restart and
+// not shared between processes; it suits single-instance services and tests.
+type MemoryEventStore struct {
+	mu      sync.Mutex
+	expires map[string]time.Time
+	swept   time.Time
+}
+
+// NewMemoryEventStore creates an empty in-memory event store.
+func NewMemoryEventStore() *MemoryEventStore {
+	return &MemoryEventStore{expires: make(map[string]time.Time)}
+}
+
+// Claim implements EventStore. Expired IDs are removed periodically.
+func (s *MemoryEventStore) Claim(ctx context.Context, id string, ttl time.Duration) (bool, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	now := time.Now()
+	if now.Sub(s.swept) > time.Minute {
+		for key, expires := range s.expires {
+			if now.After(expires) {
+				delete(s.expires, key)
+			}
+		}
+		s.swept = now
+	}
+
+	if expires, ok := s.expires[id]; ok && now.Before(expires) {
+		return false, nil
+	}
+	s.expires[id] = now.Add(ttl)
+	return true, nil
+}
+
+// Complete implements EventStore.
+func (s *MemoryEventStore) Complete(ctx context.Context, id string, ttl time.Duration) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.expires[id] = time.Now().Add(ttl)
+	return nil
+}
+
+// Release implements EventStore.
+func (s *MemoryEventStore) Release(ctx context.Context, id string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	delete(s.expires, id)
+	return nil
+}
@@ -0,0 +1,172 @@
+package documentstack
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"log"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	defaultWebhookTolerance   = 5 * time.Minute
+	defaultWebhookMaxBodySize = 1 << 20
+)
+
+// WebhookSignatureError is returned when a webhook request is not signed
+// with the webhook secret or its timestamp is outside the tolerance.
+type WebhookSignatureError struct {
+	Reason string
+}
+
+func (e *WebhookSignatureError) Error() string {
+	return "invalid webhook signature: " + e.Reason
+}
+
+// VerifyWebhookSignature checks that body was signed with secret, the
+// Webhook.Secret of the endpoint, at most tolerance ago, to reject forged and
+// replayed requests. The X-DocumentStack-Signature header holds the hex
+// HMAC-SHA256 of the X-DocumentStack-Timestamp header value (Unix seconds), a
+// period and the body; during secret rotation it may hold several
+// comma-separated signatures, any of which may match. An empty secret is
+// rejected, as anyone could sign with it.
+// Default tolerance: 5m
+func VerifyWebhookSignature(secret string, header http.Header, body []byte, tolerance time.Duration) error {
+	if secret == "" {
+		return &WebhookSignatureError{Reason: "webhook secret is empty"}
+	}
+	if tolerance <= 0 {
+		tolerance = defaultWebhookTolerance
+	}
+
+	timestamp := header.Get(timestampHeader)
+	signatures := header.Get(signatureHeader)
+	if timestamp == "" || signatures == "" {
+		return &WebhookSignatureError{Reason: "missing signature headers"}
+	}
+
+	seconds, err := strconv.ParseInt(timestamp, 10, 64)
+	if err != nil {
+		return &WebhookSignatureError{Reason: "malformed timestamp"}
+	}
+	if age := time.Since(time.Unix(seconds, 0)); age > tolerance || age < -tolerance {
+		return &WebhookSignatureError{Reason: fmt.Sprintf("timestamp is %s off, more than the %s tolerance", age.Round(time.Second), tolerance)}
+	}
+
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(timestamp))
+	mac.Write([]byte("."))
+	mac.Write(body)
+	expected := mac.Sum(nil)
+
+	for _, signature := range strings.Split(signatures, ",") {
+		decoded, err := hex.DecodeString(strings.TrimSpace(signature))
+		if err == nil && hmac.Equal(decoded, expected) {
+			return nil
+		}
+	}
+
+	return &WebhookSignatureError{Reason: "signature does not match"}
+}
+
+// WebhookHandlerOptions controls a WebhookHandler.
+type WebhookHandlerOptions struct {
+	// Tolerance is the maximum age of a request timestamp. See VerifyWebhookSignature.
+	// Default: 5m
+	Tolerance time.Duration
+
+	// MaxBodySize is the largest accepted request body in bytes.
+	// Default: 1 MiB
+	MaxBodySize int64
+
+	// OnError, if set, receives requests that were rejected or whose handler
+	// failed. Without OnError they are logged.
+	OnError func(r *http.Request, err error)
+}
+
+// WebhookHandler returns an http.Handler that receives webhook deliveries:
+// it verifies the request signature with secret, decodes the event with
+// UnmarshalEvent and calls handle with the request context.
+//
+// The response tells DocumentStack whether to redeliver the event: 2xx when
+// handle returns nil, 5xx when it returns an error, so the event is retried
+// later, and 4xx for requests that fail verification or decoding, which are
+// not retried. Events may be delivered more than once; see DeduplicateEvents.
+//
+// WebhookHandler panics if secret is empty, e.g. because an environment
+// variable is unset, rather than serve an endpoint anyone can call.
+//
+// Example:
+//
+//	mux.Handle("/hooks/documentstack", documentstack.WebhookHandler(secret, func(ctx context.Context, event *documentstack.Event) error {
+//		if completed, ok := event.GenerationCompleted(); ok {
+//			return archive(ctx, completed.DocumentID)
+//		}
+//		return nil
+//	}, nil))
+func WebhookHandler(secret string, handle func(ctx context.Context, event *Event) error, opts *WebhookHandlerOptions) http.Handler {
+	if secret == "" {
+		panic("documentstack: WebhookHandler requires a webhook secret")
+	}
+
+	var options WebhookHandlerOptions
+	if opts != nil {
+		options = *opts
+	}
+	if options.MaxBodySize <= 0 {
+		options.MaxBodySize = defaultWebhookMaxBodySize
+	}
+
+	fail := func(w http.ResponseWriter, r *http.Request, status int, err error) {
+		if options.OnError != nil {
+			options.OnError(r, err)
+		} else {
+			log.Printf("[DocumentStack] Webhook %s rejected with %d: %v\n", r.URL.Path, status, err)
+		}
+		http.Error(w, http.StatusText(status), status)
+	}
+
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost {
+			w.Header().Set("Allow", http.MethodPost)
+			fail(w, r, http.StatusMethodNotAllowed, fmt.Errorf("method %s not allowed", r.Method))
+			return
+		}
+
+		body, err := io.ReadAll(http.MaxBytesReader(w, r.Body, options.MaxBodySize))
+		if err != nil {
+			var tooLarge *http.MaxBytesError
+			if errors.As(err, &tooLarge) {
+				fail(w, r, http.StatusRequestEntityTooLarge, err)
+			} else {
+				fail(w, r, http.StatusBadRequest, err)
+			}
+			return
+		}
+
+		if err := VerifyWebhookSignature(secret, r.Header, body, options.Tolerance); err != nil {
+			fail(w, r, http.StatusUnauthorized, err)
+			return
+		}
+
+		event, err := UnmarshalEvent(body)
+		if err != nil {
+			fail(w, r, http.StatusBadRequest, err)
+			return
+		}
+
+		if err := handle(r.Context(), event); err != nil {
+			fail(w, r, http.StatusInternalServerError, fmt.Errorf("event %s: %w", event.ID, err))
+			return
+		}
+
+		w.WriteHeader(http.StatusNoContent)
+	})
+}
@@ -0,0 +1,170 @@
+package documentstack
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"slices"
+	"time"
+)
+
+// Webhook event types.
+const (
+	EventGenerationCompleted = "generation.completed"
+	EventGenerationFailed    = "generation.failed"
+)
+
+// WebhooksService manages webhook endpoints.
+type WebhooksService struct {
+	client *Client
+}
+
+// Webhook is an endpoint that receives event notifications.
+type Webhook struct {
+	// ID is the unique webhook identifier. Set by the API.
+	ID string `json:"id,omitempty"`
+
+	// URL is the endpoint events are delivered to.
+	URL string `json:"url"`
+
+	// Events are the event types delivered to this endpoint.
+	Events []string `json:"events"`
+
+	// TemplateIDs limits delivery to events of generations from these
+	// templates, so that high-volume templates can be routed to their own
+	// endpoint. Empty delivers events of all templates.
+	TemplateIDs []string `json:"templateIds,omitempty"`
+
+	// EventVersion pins the payload version of delivered events, so that
+	// consumers upgrade on their own schedule. See UnmarshalEvent.
+	// Default: CurrentEventVersion
+	EventVersion int `json:"eventVersion,omitempty"`
+
+	// Description is an optional description of the webhook.
+	Description string `json:"description,omitempty"`
+
+	// Disabled pauses delivery to this endpoint.
+	Disabled bool `json:"disabled,omitempty"`
+
+	// Secret is the signing secret. Only returned when the webhook is created.
+	Secret string `json:"secret,omitempty"`
+
+	// CreatedAt is the time the webhook was created. Set by the API.
+	CreatedAt time.Time `json:"createdAt"`
+}
+
+// WebhookList is a page of webhooks.
+type WebhookList struct {
+	Webhooks   []*Webhook `json:"webhooks"`
+	NextCursor string     `json:"nextCursor,omitempty"`
+}
+
+// List returns a page of webhooks.
+func (s *WebhooksService) List(ctx context.Context, opts *ListOptions) (*WebhookList, error) {
+	var result WebhookList
+	if err := s.client.doJSON(ctx, "GET", listPath("/api/v1/webhooks", opts), nil, &result); err != nil {
+		return nil, err
+	}
+
+	return &result, nil
+}
+
+// ListForTemplate returns the webhooks that receive events of generations
+// from templateID, including webhooks without TemplateIDs.
+func (s *WebhooksService) ListForTemplate(ctx context.Context, templateID string) ([]*Webhook, error) {
+	if templateID == "" {
+		return nil, NewValidationError("Template ID is required", nil)
+	}
+
+	webhooks, err := s.ListAll(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var matching []*Webhook
+	for _, webhook := range webhooks {
+		if len(webhook.TemplateIDs) == 0 || slices.Contains(webhook.TemplateIDs, templateID) {
+			matching = append(matching, webhook)
+		}
+	}
+	return matching, nil
+}
+
+// ListAll returns all webhooks, fetching every page. See ListAllOptions.
+func (s *WebhooksService) ListAll(ctx context.Context, opts *ListAllOptions) ([]*Webhook, error) {
+	return listAll(ctx, opts, func(page *ListOptions) ([]*Webhook, string, error) {
+		result, err := s.List(ctx, page)
+		if err != nil {
+			return nil, "", err
+		}
+		return result.Webhooks, result.NextCursor, nil
+	})
+}
+
+// Get retrieves a webhook.
+func (s *WebhooksService) Get(ctx context.Context, webhookID string) (*Webhook, error) {
+	if webhookID == "" {
+		return nil, NewValidationError("Webhook ID is required", nil)
+	}
+
+	var result Webhook
+	endpoint := fmt.Sprintf("/api/v1/webhooks/%s", url.PathEscape(webhookID))
+	if err := s.client.doJSON(ctx, "GET", endpoint, nil, &result); err != nil {
+		return nil, err
+	}
+
+	return &result, nil
+}
+
+// Create registers a new webhook. The returned webhook includes its signing secret.
+//
+// Example:
+//
+//	webhook, err := client.Webhooks.Create(ctx, &documentstack.Webhook{
+//		URL:         "https://example.com/hooks/statements",
+//		Events:      []string{documentstack.EventGenerationCompleted, documentstack.EventGenerationFailed},
+//		TemplateIDs: []string{"monthly-statement"},
+//	})
+func (s *WebhooksService) Create(ctx context.Context, webhook *Webhook) (*Webhook, error) {
+	if webhook == nil || webhook.URL == "" {
+		return nil, NewValidationError("Webhook URL is required", nil)
+	}
+
+	if webhook.EventVersion == 0 {
+		pinned := *webhook
+		pinned.EventVersion = CurrentEventVersion
+		webhook = &pinned
+	}
+
+	var result Webhook
+	if err := s.client.doJSON(ctx, "POST", "/api/v1/webhooks", webhook, &result); err != nil {
+		return nil, err
+	}
+
+	return &result, nil
+}
+
+// Update replaces the configuration of an existing webhook.
+func (s *WebhooksService) Update(ctx context.Context, webhookID string, webhook *Webhook) (*Webhook, error) {
+	if webhookID == "" {
+		return nil, NewValidationError("Webhook ID is required", nil)
+	}
+
+	var result Webhook
+	endpoint := fmt.Sprintf("/api/v1/webhooks/%s", url.PathEscape(webhookID))
+	if err := s.client.doJSON(ctx, "PUT", endpoint, webhook, &result); err != nil {
+		return nil, err
+	}
+
+	return &result, nil
+}
+
+// Delete deletes a webhook.
+func (s *WebhooksService) Delete(ctx context.Context, webhookID string) error {
+	if webhookID == "" {
+		return NewValidationError("Webhook ID is required", nil)
+	}
+
+	endpoint := fmt.Sprintf("/api/v1/webhooks/%s", url.PathEscape(webhookID))
+	return s.client.doJSON(ctx, "DELETE", endpoint, nil, nil)
+}